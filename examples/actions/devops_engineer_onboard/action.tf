@@ -0,0 +1,19 @@
+resource "devops_engineer" "new_hire" {
+  name  = "new_hire"
+  email = "new.hire@liatriolife.com"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.devops_engineer_onboard.new_hire]
+    }
+  }
+}
+
+action "devops_engineer_onboard" "new_hire" {
+  config {
+    engineer_id = devops_engineer.new_hire.id
+    steps       = ["accounts", "welcome_email"]
+    timeout     = "5m"
+  }
+}
@@ -0,0 +1,23 @@
+terraform {
+  required_providers {
+    devops = {
+      source = "liatr.io/terraform/devops-bootcamp"
+    }
+  }
+}
+
+provider "devops" {
+  host = "http://localhost:8080"
+}
+
+data "devops_dev" "by_name" {
+  name = "platform"
+}
+
+data "devops_dev" "by_id" {
+  id = data.devops_dev.by_name.id
+}
+
+output "dev_team_emails" {
+  value = [for engineer in data.devops_dev.by_name.engineers : engineer.email]
+}
@@ -0,0 +1,26 @@
+terraform {
+  required_providers {
+    devops = {
+      source = "liatr.io/terraform/devops-bootcamp"
+    }
+  }
+}
+
+provider "devops" {
+  host = "http://localhost:8080"
+}
+
+data "devops_devops" "example" {
+  id = "1"
+}
+
+output "headcount_per_team" {
+  value = merge(
+    { for team in data.devops_devops.example.devs : "dev/${team.name}" => team.headcount },
+    { for team in data.devops_devops.example.ops : "ops/${team.name}" => team.headcount },
+  )
+}
+
+output "shared_engineers" {
+  value = [for engineer in data.devops_devops.example.shared_engineers : engineer.email]
+}
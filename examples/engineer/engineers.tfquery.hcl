@@ -0,0 +1,11 @@
+# Find the engineers of a domain that are not managed yet, then generate
+# their configuration with:
+#
+#   terraform query -generate-config-out=engineers.tf
+list "devops_engineer" "liatrio" {
+  provider = devops
+
+  config {
+    email_domain = "liatriolife.com"
+  }
+}
@@ -15,6 +15,16 @@ provider "devops" {
 resource "devops_engineer" "edu" {
   name  = "LangBangGang"
   email = "pingponggod@liatriolife.com"
+
+  role       = "platform"
+  seniority  = "senior"
+  skills     = ["terraform", "go", "kubernetes"]
+  location   = "Portland, OR"
+  timezone   = "America/Los_Angeles"
+  start_date = "2024-06-03"
+  labels = {
+    cohort = "2024-summer"
+  }
 }
 
 resource "devops_engineer" "edu2" {
@@ -27,7 +37,9 @@ resource "devops_engineer" "test" {
   email = "testie@liatriolife.com"
 }
 
-data "devops_engineer" "test" {}
+data "devops_engineer" "test" {
+  email = devops_engineer.test.email
+}
 
 output "edu_engineer" {
   value = devops_engineer.edu
@@ -44,3 +56,22 @@ output "test_engineer" {
 output "test_engineer_name" {
   value = data.devops_engineer.test.name
 }
+
+resource "devops_engineer" "new_hire" {
+  name  = "new_hire"
+  email = "new.hire@liatriolife.com"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.devops_engineer_onboard.new_hire]
+    }
+  }
+}
+
+action "devops_engineer_onboard" "new_hire" {
+  config {
+    engineer_id = devops_engineer.new_hire.id
+    steps       = ["accounts", "welcome_email"]
+  }
+}
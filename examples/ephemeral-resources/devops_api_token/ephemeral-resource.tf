@@ -0,0 +1,4 @@
+ephemeral "devops_api_token" "ci" {
+  scopes = ["engineers:read", "teams:read"]
+  ttl    = "30m"
+}
@@ -11,4 +11,4 @@
   host     = "http://localhost:8080"
  }
 
-data "devops_engineer" "example" {}
+data "devops_engineers" "example" {}
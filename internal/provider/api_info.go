@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// APIInfo maps the server information returned by the DevOps API.
+type APIInfo struct {
+	Version  string          `json:"version"`
+	Features map[string]bool `json:"features"`
+}
+
+// WhoAmI maps the identity of the caller as seen by the DevOps API.
+type WhoAmI struct {
+	Principal   string   `json:"principal"`
+	Permissions []string `json:"permissions"`
+}
+
+// GetAPIInfo - Returns the server version and feature flags (no auth required)
+func (c *Client) GetAPIInfo() (*APIInfo, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/info", c.HostURL), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	info := APIInfo{}
+	err = json.Unmarshal(body, &info)
+	if err != nil {
+		return nil, err
+	}
+
+	return &info, nil
+}
+
+// GetWhoAmI - Returns the principal the client is authenticated as and its permissions
+func (c *Client) GetWhoAmI() (*WhoAmI, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/whoami", c.HostURL), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	whoami := WhoAmI{}
+	err = json.Unmarshal(body, &whoami)
+	if err != nil {
+		return nil, err
+	}
+
+	return &whoami, nil
+}
@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &apiInfoDataSource{}
+	_ datasource.DataSourceWithConfigure = &apiInfoDataSource{}
+)
+
+// NewAPIInfoDataSource is a helper function to simplify the provider implementation.
+func NewAPIInfoDataSource() datasource.DataSource {
+	return &apiInfoDataSource{}
+}
+
+// apiInfoDataSource exposes what the DevOps API is and what the caller may
+// do with it, so modules can check their requirements in preconditions.
+type apiInfoDataSource struct {
+	client *Client
+}
+
+// apiInfoDataSourceModel maps the data source schema data.
+type apiInfoDataSourceModel struct {
+	Version      types.String `tfsdk:"version"`
+	VersionMajor types.Int64  `tfsdk:"version_major"`
+	VersionMinor types.Int64  `tfsdk:"version_minor"`
+	VersionPatch types.Int64  `tfsdk:"version_patch"`
+	Features     types.Map    `tfsdk:"features"`
+	Principal    types.String `tfsdk:"principal"`
+	Permissions  types.Set    `tfsdk:"permissions"`
+}
+
+// Metadata returns the data source type name.
+func (d *apiInfoDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_info"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *apiInfoDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Schema defines the schema for the data source.
+func (d *apiInfoDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Exposes the DevOps API server version, its feature flags and the identity of the caller.",
+		Attributes: map[string]schema.Attribute{
+			"version": schema.StringAttribute{
+				Description: "Version of the DevOps API server, as reported by the server.",
+				Computed:    true,
+			},
+			"version_major": schema.Int64Attribute{
+				Description: "Major component of version, null when version is not of the form `[v]MAJOR.MINOR[.PATCH]`.",
+				Computed:    true,
+			},
+			"version_minor": schema.Int64Attribute{
+				Description: "Minor component of version, null when version is not of the form `[v]MAJOR.MINOR[.PATCH]`.",
+				Computed:    true,
+			},
+			"version_patch": schema.Int64Attribute{
+				Description: "Patch component of version, null when version is not of the form `[v]MAJOR.MINOR.PATCH`, e.g. `2.1`.",
+				Computed:    true,
+			},
+			"features": schema.MapAttribute{
+				Description: "Feature flags of the server, keyed by feature name.",
+				ElementType: types.BoolType,
+				Computed:    true,
+			},
+			"principal": schema.StringAttribute{
+				Description: "Principal the provider is authenticated as.",
+				Computed:    true,
+			},
+			"permissions": schema.SetAttribute{
+				Description: "Permissions granted to the principal.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *apiInfoDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	info, err := d.client.GetAPIInfo()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read DevOps API info",
+			err.Error(),
+		)
+		return
+	}
+
+	whoami, err := d.client.GetWhoAmI()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read DevOps API caller identity",
+			err.Error(),
+		)
+		return
+	}
+
+	var state apiInfoDataSourceModel
+	resp.Diagnostics.Append(state.refresh(ctx, info, whoami)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// refresh overwrites the model with the API server information and caller identity.
+func (m *apiInfoDataSourceModel) refresh(ctx context.Context, info *APIInfo, whoami *WhoAmI) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	m.Version = types.StringValue(info.Version)
+	m.VersionMajor = types.Int64Null()
+	m.VersionMinor = types.Int64Null()
+	m.VersionPatch = types.Int64Null()
+	if version, ok := parseAPIVersion(info.Version); ok {
+		m.VersionMajor = types.Int64Value(version[0])
+		m.VersionMinor = types.Int64Value(version[1])
+		if len(version) > 2 {
+			m.VersionPatch = types.Int64Value(version[2])
+		}
+	}
+	m.Principal = types.StringValue(whoami.Principal)
+
+	features := info.Features
+	if features == nil {
+		features = map[string]bool{}
+	}
+	m.Features, d = types.MapValueFrom(ctx, types.BoolType, features)
+	diags.Append(d...)
+
+	permissions := whoami.Permissions
+	if permissions == nil {
+		permissions = []string{}
+	}
+	m.Permissions, d = types.SetValueFrom(ctx, types.StringType, permissions)
+	diags.Append(d...)
+
+	return diags
+}
+
+// parseAPIVersion splits a version of the form [v]MAJOR.MINOR[.PATCH], with
+// an optional pre-release or build suffix, into its two or three numeric
+// components.
+func parseAPIVersion(version string) ([]int64, bool) {
+	version = strings.TrimPrefix(version, "v")
+	if i := strings.IndexAny(version, "-+"); i >= 0 {
+		version = version[:i]
+	}
+
+	parts := strings.Split(version, ".")
+	if len(parts) != 2 && len(parts) != 3 {
+		return nil, false
+	}
+	parsed := make([]int64, len(parts))
+	for i, part := range parts {
+		n, err := strconv.ParseInt(part, 10, 64)
+		if err != nil || n < 0 {
+			return nil, false
+		}
+		parsed[i] = n
+	}
+
+	return parsed, true
+}
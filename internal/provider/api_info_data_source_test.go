@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAPIInfoDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+data "devops_api_info" "test" {}
+
+output "can_write" {
+  value = contains(data.devops_api_info.test.permissions, "write")
+
+  precondition {
+    condition     = data.devops_api_info.test.version_major != null
+    error_message = "The DevOps API did not report a semantic version."
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.devops_api_info.test", "version"),
+					resource.TestCheckResourceAttrSet("data.devops_api_info.test", "version_major"),
+					resource.TestCheckResourceAttrSet("data.devops_api_info.test", "principal"),
+				),
+			},
+		},
+	})
+}
+
+func TestParseAPIVersion(t *testing.T) {
+	cases := map[string]struct {
+		version string
+		want    []int64
+	}{
+		"major minor patch":    {version: "2.1.3", want: []int64{2, 1, 3}},
+		"major minor":          {version: "2.1", want: []int64{2, 1}},
+		"v prefix":             {version: "v1.0.0", want: []int64{1, 0, 0}},
+		"v prefix major minor": {version: "v2.1", want: []int64{2, 1}},
+		"pre-release":          {version: "v1.0.0-fake", want: []int64{1, 0, 0}},
+		"pre-release on minor": {version: "2.1-rc.1", want: []int64{2, 1}},
+		"build metadata":       {version: "1.2.3+build.5", want: []int64{1, 2, 3}},
+		"large components":     {version: "10.20.30", want: []int64{10, 20, 30}},
+		"major only":           {version: "2"},
+		"four components":      {version: "1.2.3.4"},
+		"empty component":      {version: "2..1"},
+		"trailing dot":         {version: "2.1."},
+		"not a number":         {version: "2.x"},
+		"date":                 {version: "2024-06-04"},
+		"empty":                {version: ""},
+		"upper case v prefix":  {version: "V2.1"},
+		"surrounding spaces":   {version: " 2.1 "},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, ok := parseAPIVersion(tc.version)
+			if ok != (tc.want != nil) {
+				t.Fatalf("parseAPIVersion(%q): expected ok %t, got %t (%v)", tc.version, tc.want != nil, ok, got)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("parseAPIVersion(%q): expected %v, got %v", tc.version, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestAPIInfoDataSourceModel_refresh(t *testing.T) {
+	var model apiInfoDataSourceModel
+	if diags := model.refresh(context.Background(), &APIInfo{Version: "v2.1"}, &WhoAmI{Principal: "ci"}); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if model.VersionMajor.ValueInt64() != 2 || model.VersionMinor.ValueInt64() != 1 {
+		t.Errorf("expected version 2.1, got %s.%s", model.VersionMajor, model.VersionMinor)
+	}
+	if !model.VersionPatch.IsNull() {
+		t.Errorf("expected a null patch version, got %s", model.VersionPatch)
+	}
+}
@@ -0,0 +1,303 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultAPITokenTTL is the lifetime of tokens when ttl is not configured.
+const defaultAPITokenTTL = 15 * time.Minute
+
+// privateAPITokenKey is the private data key holding the apiTokenPrivate
+// of an open token.
+const privateAPITokenKey = "token"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource                   = &apiTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure      = &apiTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &apiTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithRenew          = &apiTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose          = &apiTokenEphemeralResource{}
+)
+
+// NewAPITokenEphemeralResource is a helper function to simplify the provider implementation.
+func NewAPITokenEphemeralResource() ephemeral.EphemeralResource {
+	return &apiTokenEphemeralResource{}
+}
+
+// apiTokenEphemeralResource mints a short-lived API token for the duration
+// of a Terraform run. The token never reaches plan or state.
+type apiTokenEphemeralResource struct {
+	client *Client
+}
+
+// apiTokenEphemeralResourceModel maps the ephemeral resource schema data.
+type apiTokenEphemeralResourceModel struct {
+	Scopes    types.Set    `tfsdk:"scopes"`
+	TTL       types.String `tfsdk:"ttl"`
+	Id        types.String `tfsdk:"id"`
+	Token     types.String `tfsdk:"token"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+}
+
+// apiTokenPrivate is what Renew and Close need to know about an open token.
+type apiTokenPrivate struct {
+	Id         string `json:"id"`
+	TTLSeconds int64  `json:"ttl_seconds"`
+}
+
+// Metadata returns the ephemeral resource type name.
+func (e *apiTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_token"
+}
+
+// Configure adds the provider configured client to the ephemeral resource.
+func (e *apiTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	e.client = client
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (e *apiTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Mints a scoped, short-lived DevOps API token for the duration of a Terraform run. " +
+			"The token is renewed while the run needs it, revoked when the run is done and never written to plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"scopes": schema.SetAttribute{
+				Description: "Scopes the token is limited to, e.g. `engineers:read`.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"ttl": schema.StringAttribute{
+				Description: "Lifetime of the token, and of each renewal, as a Go duration such as `30m`. Defaults to `15m`.",
+				Optional:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "ID of the token.",
+				Computed:    true,
+			},
+			"token": schema.StringAttribute{
+				Description: "Token value to send as bearer token.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "Time the token was first set to expire, in RFC 3339 format. Renewals push it back.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig rejects ttl values that are not positive durations.
+func (e *apiTokenEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var ttl types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("ttl"), &ttl)...)
+	if resp.Diagnostics.HasError() || ttl.IsUnknown() {
+		return
+	}
+
+	_, diags := parseAPITokenTTL(ttl)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Open mints the token.
+func (e *apiTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data apiTokenEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ttl, diags := parseAPITokenTTL(data.TTL)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var scopes []string
+	resp.Diagnostics.Append(data.Scopes.ElementsAs(ctx, &scopes, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := e.client.CreateAPIToken(ctx, scopes, ttl)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating API token",
+			"Could not create API token, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.Id = types.StringValue(token.Id)
+	data.Token = types.StringValue(token.Token)
+	data.ExpiresAt = types.StringValue(token.ExpiresAt)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(setAPITokenPrivate(ctx, resp.Private, apiTokenPrivate{
+			Id:         token.Id,
+			TTLSeconds: int64(ttl.Seconds()),
+		})...)
+	}
+
+	// Terraform never closes a token it failed to open, revoke it now
+	if resp.Diagnostics.HasError() {
+		if err := e.client.RevokeAPIToken(ctx, token.Id); err != nil {
+			resp.Diagnostics.AddError(
+				"Error revoking API token",
+				"Could not revoke API token "+token.Id+" after failing to open it, it stays valid until "+token.ExpiresAt+". Unexpected error: "+err.Error(),
+			)
+		}
+		return
+	}
+
+	renewAt, diags := apiTokenRenewAt(token)
+	resp.Diagnostics.Append(diags...)
+	resp.RenewAt = renewAt
+}
+
+// Renew extends the validity of the token while Terraform still needs it.
+func (e *apiTokenEphemeralResource) Renew(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+	private, diags := getAPITokenPrivate(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := e.client.RenewAPIToken(ctx, private.Id, time.Duration(private.TTLSeconds)*time.Second)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error renewing API token",
+			"Could not renew API token "+private.Id+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	renewAt, diags := apiTokenRenewAt(token)
+	resp.Diagnostics.Append(diags...)
+	resp.RenewAt = renewAt
+}
+
+// Close revokes the token once Terraform is done with it.
+func (e *apiTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	private, diags := getAPITokenPrivate(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A token that is gone has expired already, there is nothing to revoke.
+	err := e.client.RevokeAPIToken(ctx, private.Id)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"Error revoking API token",
+			"Could not revoke API token "+private.Id+", unexpected error: "+err.Error(),
+		)
+	}
+}
+
+// parseAPITokenTTL returns the token lifetime configured by ttl, or
+// defaultAPITokenTTL when it is null.
+func parseAPITokenTTL(ttl types.String) (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if ttl.IsNull() {
+		return defaultAPITokenTTL, diags
+	}
+
+	duration, err := time.ParseDuration(ttl.ValueString())
+	if err != nil || duration <= 0 {
+		diags.AddAttributeError(
+			path.Root("ttl"),
+			"Invalid Token TTL",
+			fmt.Sprintf("Expected a positive Go duration such as 30m. Got: %q", ttl.ValueString()),
+		)
+	}
+
+	return duration, diags
+}
+
+// apiTokenRenewAt returns when to renew token: once 80% of its remaining
+// lifetime has passed, so the renewal lands well before it expires.
+func apiTokenRenewAt(token *APIToken) (time.Time, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	expiresAt, err := time.Parse(time.RFC3339, token.ExpiresAt)
+	if err != nil {
+		diags.AddWarning(
+			"Unexpected API Token Expiry",
+			fmt.Sprintf("Could not parse the expiry %q of API token %s, it will not be renewed: %s", token.ExpiresAt, token.Id, err),
+		)
+		return time.Time{}, diags
+	}
+
+	now := time.Now()
+	return now.Add(expiresAt.Sub(now) * 4 / 5), diags
+}
+
+// getAPITokenPrivate returns the token stored by Open in private data.
+func getAPITokenPrivate(ctx context.Context, private privateStateGetter) (apiTokenPrivate, diag.Diagnostics) {
+	var token apiTokenPrivate
+
+	value, diags := private.GetKey(ctx, privateAPITokenKey)
+	if diags.HasError() {
+		return token, diags
+	}
+
+	if err := json.Unmarshal(value, &token); err != nil || token.Id == "" {
+		diags.AddError(
+			"Missing API Token",
+			"The ephemeral resource private data does not identify an API token. "+
+				"Please report this issue to the provider developers.",
+		)
+	}
+
+	return token, diags
+}
+
+// setAPITokenPrivate stores token in private data for Renew and Close.
+func setAPITokenPrivate(ctx context.Context, private privateStateSetter, token apiTokenPrivate) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	value, err := json.Marshal(token)
+	if err != nil {
+		diags.AddError(
+			"Unable to Store API Token",
+			"Could not encode the API token private data, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	return private.SetKey(ctx, privateAPITokenKey, value)
+}
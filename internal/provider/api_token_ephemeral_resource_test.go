@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAPITokenEphemeralResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		// The echo provider copies the ephemeral token into state, which
+		// Terraform would never allow for the token itself.
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"devops": providerserver.NewProtocol6WithError(New("test")()),
+			"echo":   echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+ephemeral "devops_api_token" "test" {
+  scopes = ["engineers:read"]
+  ttl    = "5m"
+}
+
+provider "echo" {
+  data = ephemeral.devops_api_token.test
+}
+
+resource "echo" "test" {}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("token"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("expires_at"), knownvalue.NotNull()),
+					expectEchoedTokenRevoked{},
+				},
+			},
+			{
+				Config: providerConfig + `
+ephemeral "devops_api_token" "test" {
+  scopes = ["engineers:read"]
+  ttl    = "soon"
+}
+
+provider "echo" {
+  data = ephemeral.devops_api_token.test
+}
+
+resource "echo" "test" {}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Token TTL`),
+			},
+		},
+	})
+}
+
+func TestAPITokenEphemeralResource_openClose(t *testing.T) {
+	ctx := context.Background()
+	server := testProviderServer(t)
+
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	objectType := schemaResp.EphemeralResourceSchemas["devops_api_token"].ValueType().(tftypes.Object)
+	config := func(ttl string) *tfprotov6.DynamicValue {
+		return testDynamicValue(t, objectType, testObjectValue(t, objectType, map[string]tftypes.Value{
+			"scopes": tftypes.NewValue(objectType.AttributeTypes["scopes"], []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "engineers:read"),
+			}),
+			"ttl": tftypes.NewValue(tftypes.String, ttl),
+		}))
+	}
+
+	// Invalid TTLs are rejected before any token is opened
+	validateResp, err := server.ValidateEphemeralResourceConfig(ctx, &tfprotov6.ValidateEphemeralResourceConfigRequest{
+		TypeName: "devops_api_token",
+		Config:   config("soon"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(validateResp.Diagnostics) != 1 || validateResp.Diagnostics[0].Summary != "Invalid Token TTL" {
+		t.Fatalf("expected an invalid TTL error, got: %v", validateResp.Diagnostics)
+	}
+
+	openResp, err := server.OpenEphemeralResource(ctx, &tfprotov6.OpenEphemeralResourceRequest{
+		TypeName: "devops_api_token",
+		Config:   config("5m"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(openResp.Diagnostics) > 0 {
+		t.Fatalf("unexpected diagnostics opening: %v", openResp.Diagnostics)
+	}
+
+	var result map[string]tftypes.Value
+	var id string
+	if err := testNewState(t, objectType, openResp.Result).As(&result); err != nil {
+		t.Fatal(err)
+	}
+	if err := result["id"].As(&id); err != nil {
+		t.Fatal(err)
+	}
+	if !testAccAPI.hasToken(id) {
+		t.Fatalf("expected token %s to be valid once opened", id)
+	}
+
+	closeResp, err := server.CloseEphemeralResource(ctx, &tfprotov6.CloseEphemeralResourceRequest{
+		TypeName: "devops_api_token",
+		Private:  openResp.Private,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(closeResp.Diagnostics) > 0 {
+		t.Fatalf("unexpected diagnostics closing: %v", closeResp.Diagnostics)
+	}
+	if testAccAPI.hasToken(id) {
+		t.Errorf("expected token %s to be revoked once closed", id)
+	}
+}
+
+// expectEchoedTokenRevoked checks that the token copied into echo.test was
+// revoked once Terraform closed it.
+type expectEchoedTokenRevoked struct{}
+
+func (expectEchoedTokenRevoked) CheckState(_ context.Context, req statecheck.CheckStateRequest, resp *statecheck.CheckStateResponse) {
+	if req.State == nil || req.State.Values == nil {
+		resp.Error = fmt.Errorf("no state to find echo.test in")
+		return
+	}
+
+	for _, r := range req.State.Values.RootModule.Resources {
+		if r.Address != "echo.test" {
+			continue
+		}
+		data, _ := r.AttributeValues["data"].(map[string]any)
+		id, _ := data["id"].(string)
+		if testAccAPI.hasToken(id) {
+			resp.Error = fmt.Errorf("token %s is still valid after Terraform closed it", id)
+		}
+		return
+	}
+	resp.Error = fmt.Errorf("echo.test not found in state")
+}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"errors"
 	"fmt"
         "io/ioutil"
 	"net/http"
@@ -10,10 +11,25 @@ import (
 // HostURL default DevOps API URL
 const HostURL = "http://localhost:8080"
 
+// ErrNotFound is returned when the DevOps API responds with 404 Not Found.
+var ErrNotFound = errors.New("not found")
+
+// ErrNotModified is returned when a conditional request is answered with
+// 304 Not Modified.
+var ErrNotModified = errors.New("not modified")
+
 // Client devops API client
 type Client struct {
     HostURL string
     HTTPClient  *http.Client
+
+    // engineerPlans is shared by every devops_engineer and devops_engineers
+    // instance planned with this client.
+    engineerPlans *engineerPlanCache
+
+    // operationPollInterval is the wait before polling an operation for
+    // the first time, tests shorten it.
+    operationPollInterval time.Duration
 }
 
 func NewClient(host *string) (*Client, error) {
@@ -21,6 +37,8 @@ func NewClient(host *string) (*Client, error) {
 		HTTPClient: &http.Client{Timeout: 10 * time.Second},
 		// Default Hashicups URL
 		HostURL: HostURL,
+		engineerPlans: newEngineerPlanCache(),
+		operationPollInterval: operationPollInitialInterval,
 	}
 
 	if host != nil {
@@ -31,20 +49,36 @@ func NewClient(host *string) (*Client, error) {
 }
 
 func (c *Client) doRequest(req *http.Request) ([]byte, error) {
+	body, _, err := c.doRequestWithResponse(req)
+	return body, err
+}
+
+// doRequestWithResponse is doRequest for callers that also need the status
+// code or headers of the response, e.g. the ETag. The returned response
+// body has already been read.
+func (c *Client) doRequestWithResponse(req *http.Request) ([]byte, *http.Response, error) {
 	res, err := c.HTTPClient.Do(req)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer res.Body.Close()
 
 	body, err := ioutil.ReadAll(res.Body)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	if res.StatusCode == http.StatusNotModified {
+		return nil, res, ErrNotModified
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil, fmt.Errorf("%w: status: %d, body: %s", ErrNotFound, res.StatusCode, body)
 	}
 
 	if res.StatusCode < 200 || res.StatusCode > 299 {
-		return nil, fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
+		return nil, nil, fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
 	}
 
-	return body, nil
+	return body, res, nil
 }
@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// DevOps maps a DevOps unit returned by the DevOps API, grouping dev and
+// ops teams.
+type DevOps struct {
+	Id   string `json:"id"`
+	Devs []Team `json:"devs"`
+	Ops  []Team `json:"ops"`
+}
+
+// GetDevOps - Returns a specific DevOps unit with its teams and their engineers
+func (c *Client) GetDevOps(devopsID string) (*DevOps, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/devops/id/%s", c.HostURL, devopsID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	devops := DevOps{}
+	err = json.Unmarshal(body, &devops)
+	if err != nil {
+		return nil, err
+	}
+
+	return &devops, nil
+}
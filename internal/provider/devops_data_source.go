@@ -0,0 +1,222 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &devopsDataSource{}
+	_ datasource.DataSourceWithConfigure = &devopsDataSource{}
+)
+
+// NewDevOpsDataSource is a helper function to simplify the provider implementation.
+func NewDevOpsDataSource() datasource.DataSource {
+	return &devopsDataSource{}
+}
+
+// devopsDataSource reads a DevOps unit with its whole team tree.
+type devopsDataSource struct {
+	client *Client
+}
+
+// devopsDataSourceModel maps the data source schema data.
+type devopsDataSourceModel struct {
+	Id              types.String      `tfsdk:"id"`
+	Devs            []devopsTeamModel `tfsdk:"devs"`
+	Ops             []devopsTeamModel `tfsdk:"ops"`
+	Engineers       []teamMemberModel `tfsdk:"engineers"`
+	SharedEngineers []teamMemberModel `tfsdk:"shared_engineers"`
+	Headcount       types.Int64       `tfsdk:"headcount"`
+}
+
+// devopsTeamModel maps a dev or ops team of a DevOps unit.
+type devopsTeamModel struct {
+	Id        types.String      `tfsdk:"id"`
+	Name      types.String      `tfsdk:"name"`
+	Headcount types.Int64       `tfsdk:"headcount"`
+	Engineers []teamMemberModel `tfsdk:"engineers"`
+}
+
+// Metadata returns the data source type name.
+func (d *devopsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_devops"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *devopsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Schema defines the schema for the data source.
+func (d *devopsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	team := schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Computed: true,
+			},
+			"headcount": schema.Int64Attribute{
+				Description: "Number of engineers in the team.",
+				Computed:    true,
+			},
+			"engineers": schema.ListNestedAttribute{
+				Description: "Members of the team, sorted by email then ID.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: teamMemberAttributes(),
+				},
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Reads a DevOps unit with its dev teams, ops teams and engineers in one lookup.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the DevOps unit to look up.",
+				Required:    true,
+			},
+			"devs": schema.ListNestedAttribute{
+				Description:  "Dev teams of the unit, sorted by name then ID.",
+				Computed:     true,
+				NestedObject: team,
+			},
+			"ops": schema.ListNestedAttribute{
+				Description:  "Ops teams of the unit, sorted by name then ID.",
+				Computed:     true,
+				NestedObject: team,
+			},
+			"engineers": schema.ListNestedAttribute{
+				Description: "Every engineer of the unit, listed once even when in several teams.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: teamMemberAttributes(),
+				},
+			},
+			"shared_engineers": schema.ListNestedAttribute{
+				Description: "Engineers that belong to both a dev team and an ops team of the unit.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: teamMemberAttributes(),
+				},
+			},
+			"headcount": schema.Int64Attribute{
+				Description: "Number of distinct engineers in the unit.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *devopsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state devopsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	devops, err := d.client.GetDevOps(state.Id.ValueString())
+	if errors.Is(err, ErrNotFound) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"DevOps Unit Not Found",
+			fmt.Sprintf("No DevOps unit with id %q exists.", state.Id.ValueString()),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read DevOps unit",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Devs = newDevOpsTeamModels(devops.Devs)
+	state.Ops = newDevOpsTeamModels(devops.Ops)
+
+	// Roll the teams up into the distinct engineers of the unit, noting
+	// which side of the unit each of them works on.
+	engineers := map[string]Engineer{}
+	inDev := map[string]bool{}
+	inOps := map[string]bool{}
+	for _, team := range devops.Devs {
+		for _, engineer := range team.Engineers {
+			engineers[engineer.Id] = engineer
+			inDev[engineer.Id] = true
+		}
+	}
+	for _, team := range devops.Ops {
+		for _, engineer := range team.Engineers {
+			engineers[engineer.Id] = engineer
+			inOps[engineer.Id] = true
+		}
+	}
+
+	all := make([]Engineer, 0, len(engineers))
+	shared := []Engineer{}
+	for id, engineer := range engineers {
+		all = append(all, engineer)
+		if inDev[id] && inOps[id] {
+			shared = append(shared, engineer)
+		}
+	}
+
+	state.Engineers = newTeamMemberModels(all)
+	state.SharedEngineers = newTeamMemberModels(shared)
+	state.Headcount = types.Int64Value(int64(len(all)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// newDevOpsTeamModels returns the models of the given teams, sorted by name
+// then ID.
+func newDevOpsTeamModels(teams []Team) []devopsTeamModel {
+	sorted := make([]Team, len(teams))
+	copy(sorted, teams)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Name != sorted[j].Name {
+			return sorted[i].Name < sorted[j].Name
+		}
+		return sorted[i].Id < sorted[j].Id
+	})
+
+	models := make([]devopsTeamModel, len(sorted))
+	for i, team := range sorted {
+		models[i] = devopsTeamModel{
+			Id:        types.StringValue(team.Id),
+			Name:      types.StringValue(team.Name),
+			Headcount: types.Int64Value(int64(len(team.Engineers))),
+			Engineers: newTeamMemberModels(team.Engineers),
+		}
+	}
+	return models
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestDevOpsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read the seeded unit
+			{
+				Config: providerConfig + `data "devops_devops" "test" { id = "` + testAccDevOpsUnitId + `" }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.devops_devops.test", "devs.#", "1"),
+					resource.TestCheckResourceAttr("data.devops_devops.test", "devs.0.name", testAccDevTeamName),
+					resource.TestCheckResourceAttr("data.devops_devops.test", "ops.#", "1"),
+					resource.TestCheckResourceAttr("data.devops_devops.test", "ops.0.name", testAccOpsTeamName),
+					resource.TestCheckResourceAttr("data.devops_devops.test", "shared_engineers.#", "0"),
+				),
+			},
+			// Read the unit whose teams share an engineer
+			{
+				Config: providerConfig + `data "devops_devops" "rollup" { id = "` + testAccRollupDevOpsUnitId + `" }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.devops_devops.rollup", "devs.#", "1"),
+					resource.TestCheckResourceAttr("data.devops_devops.rollup", "devs.0.name", testAccRollupDevTeamName),
+					resource.TestCheckResourceAttr("data.devops_devops.rollup", "devs.0.headcount", "2"),
+					resource.TestCheckResourceAttr("data.devops_devops.rollup", "devs.0.engineers.#", "2"),
+					resource.TestCheckResourceAttr("data.devops_devops.rollup", "ops.#", "1"),
+					resource.TestCheckResourceAttr("data.devops_devops.rollup", "ops.0.name", testAccRollupOpsTeamName),
+					resource.TestCheckResourceAttr("data.devops_devops.rollup", "ops.0.headcount", "2"),
+					resource.TestCheckResourceAttr("data.devops_devops.rollup", "ops.0.engineers.#", "2"),
+					resource.TestCheckResourceAttr("data.devops_devops.rollup", "shared_engineers.#", "1"),
+					resource.TestCheckResourceAttr("data.devops_devops.rollup", "shared_engineers.0.email", testAccSharedEngineerEmail),
+					// The shared engineer is only counted once
+					resource.TestCheckResourceAttr("data.devops_devops.rollup", "engineers.#", "3"),
+					resource.TestCheckResourceAttr("data.devops_devops.rollup", "headcount", "3"),
+				),
+			},
+			// Unknown units are reported as not found
+			{
+				Config:      providerConfig + `data "devops_devops" "test" { id = "no-such-unit" }`,
+				ExpectError: regexp.MustCompile(`DevOps Unit Not Found`),
+			},
+		},
+	})
+}
@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ basetypes.StringTypable                    = EmailType{}
+	_ basetypes.StringValuableWithSemanticEquals = EmailValue{}
+	_ xattr.ValidateableAttribute                = EmailValue{}
+)
+
+// EmailType is a string type for email addresses. Its values are validated
+// against RFC 5322 and compare case-insensitively, matching the API which
+// stores every email in lower case.
+type EmailType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t EmailType) String() string {
+	return "provider.EmailType"
+}
+
+// ValueType returns the Value type.
+func (t EmailType) ValueType(_ context.Context) attr.Value {
+	return EmailValue{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t EmailType) Equal(o attr.Type) bool {
+	other, ok := o.(EmailType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t EmailType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return EmailValue{StringValue: in}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.
+func (t EmailType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// EmailValue is a value of EmailType.
+type EmailValue struct {
+	basetypes.StringValue
+}
+
+// NewEmailValue creates an EmailValue with a known value.
+func NewEmailValue(value string) EmailValue {
+	return EmailValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+// NewEmailNull creates an EmailValue with a null value.
+func NewEmailNull() EmailValue {
+	return EmailValue{StringValue: basetypes.NewStringNull()}
+}
+
+// Type returns an EmailType.
+func (v EmailValue) Type(_ context.Context) attr.Type {
+	return EmailType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v EmailValue) Equal(o attr.Value) bool {
+	other, ok := o.(EmailValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if both emails only differ in case, so
+// a mixed-case configuration does not diff against the lower-cased value
+// returned by the API.
+func (v EmailValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(EmailValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+		return false, diags
+	}
+
+	return strings.EqualFold(v.ValueString(), newValue.ValueString()), diags
+}
+
+// ValidateAttribute checks that the value is a single RFC 5322 address
+// without a display name, e.g. jane@corp.com but not "Jane <jane@corp.com>".
+func (v EmailValue) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if err := validateEmail(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Email Address",
+			fmt.Sprintf("A string value was provided that is not a valid email address.\n\nGiven Value: %s\nError: %s", v.ValueString(), err),
+		)
+	}
+}
+
+// validateEmail returns an error unless email is a bare RFC 5322 address.
+func validateEmail(email string) error {
+	address, err := mail.ParseAddress(email)
+	if err != nil {
+		return err
+	}
+
+	if address.Name != "" || address.Address != email {
+		return fmt.Errorf("expected a bare address such as %q", address.Address)
+	}
+
+	return nil
+}
+
+// normalizeEmail returns email in the form the API stores it in: without
+// surrounding whitespace and in lower case. It returns an error unless the
+// result is a bare RFC 5322 address.
+func normalizeEmail(email string) (string, error) {
+	normalized := strings.ToLower(strings.TrimSpace(email))
+	if err := validateEmail(normalized); err != nil {
+		return "", err
+	}
+
+	return normalized, nil
+}
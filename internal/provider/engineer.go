@@ -1,17 +1,72 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
 	"strings"
+	"sync"
         "errors"
 )
 
+const (
+	// engineerBatchSize is the number of engineers handled per batch by the
+	// bulk engineer operations.
+	engineerBatchSize = 25
+
+	// engineerBatchParallelism bounds the number of requests in flight
+	// within a single batch.
+	engineerBatchParallelism = 5
+)
+
+// errBatchAborted is reported for every item that was not attempted because
+// an earlier batch failed.
+var errBatchAborted = errors.New("not attempted, an earlier batch failed")
+
+// Engineer maps an engineer as exchanged with the DevOps API.
+type Engineer struct {
+	Id        string   `json:"id,omitempty"`
+	Name      string   `json:"name"`
+	Email     string   `json:"email"`
+	Role      string   `json:"role,omitempty"`
+	Seniority string   `json:"seniority,omitempty"`
+	Skills    []string `json:"skills,omitempty"`
+	Location  string   `json:"location,omitempty"`
+	Timezone  string   `json:"timezone,omitempty"`
+	StartDate string   `json:"start_date,omitempty"`
+	// Active is a pointer so that servers which predate the attribute,
+	// and therefore omit it, are not mistaken for inactive engineers.
+	Active    *bool             `json:"active,omitempty"`
+	Labels    map[string]string `json:"labels,omitempty"`
+	CreatedAt string            `json:"created_at,omitempty"`
+	UpdatedAt string            `json:"updated_at,omitempty"`
+
+	// InitialCredential is only ever sent, the API never returns it.
+	InitialCredential string `json:"initial_credential,omitempty"`
+
+	// Revision is the ETag the API returned with the engineer, empty when
+	// the API does not version engineers.
+	Revision string `json:"-"`
+}
+
+// IsActive reports whether the engineer is active, engineers are active
+// unless the API says otherwise.
+func (e *Engineer) IsActive() bool {
+	return e.Active == nil || *e.Active
+}
 
 // GetEngineers - Returns list of engineers (no auth required)
-func (c *Client) GetEngineers() ([]engineerDataSourceModel, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/engineers", c.HostURL), nil)
+func (c *Client) GetEngineers() ([]Engineer, error) {
+	return c.getEngineers(context.Background())
+}
+
+// getEngineers is GetEngineers bounded by ctx.
+func (c *Client) getEngineers(ctx context.Context) ([]Engineer, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/engineers", c.HostURL), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -21,7 +76,7 @@ func (c *Client) GetEngineers() ([]engineerDataSourceModel, error) {
 		return nil, err
 	}
 
-	engineers := []engineerDataSourceModel{}
+	engineers := []Engineer{}
 	err = json.Unmarshal(body, &engineers)
 	if err != nil {
 		return nil, err
@@ -30,99 +85,308 @@ func (c *Client) GetEngineers() ([]engineerDataSourceModel, error) {
 	return engineers, nil
 }
 
+// EngineerFilter selects engineers by their attributes and team
+// membership. Empty fields do not filter.
+type EngineerFilter struct {
+	NameRegex   *regexp.Regexp
+	EmailDomain string
+	Labels      map[string]string
+	TeamType    string
+	TeamId      string
+}
+
+// Matches reports whether the engineer passes the attribute filters. Team
+// membership is not known from the engineer alone and is checked by
+// GetEngineersWithFilter.
+func (f EngineerFilter) Matches(engineer Engineer) bool {
+	if f.NameRegex != nil && !f.NameRegex.MatchString(engineer.Name) {
+		return false
+	}
+	if f.EmailDomain != "" {
+		_, domain, _ := strings.Cut(engineer.Email, "@")
+		if !strings.EqualFold(domain, strings.TrimPrefix(f.EmailDomain, "@")) {
+			return false
+		}
+	}
+	for key, value := range f.Labels {
+		if got, ok := engineer.Labels[key]; !ok || got != value {
+			return false
+		}
+	}
+	return true
+}
+
+// Query parameters GetEngineersWithFilter sends for the filters the API can
+// apply itself. Name regular expressions use Go syntax and are always
+// matched locally.
+const (
+	engineerFilterEmailDomain = "email_domain"
+	engineerFilterLabels      = "labels"
+	engineerFilterTeam        = "team"
+)
+
+// appliedFiltersHeader lists, comma-separated, the filter query parameters
+// the API applied to an engineer list.
+const appliedFiltersHeader = "X-Applied-Filters"
+
+// query returns the query parameters of the filters the API can apply: the
+// email domain, every label as key=value and the team as
+// <team_type>/<team_id>.
+func (f EngineerFilter) query() url.Values {
+	query := url.Values{}
+	if f.EmailDomain != "" {
+		query.Set(engineerFilterEmailDomain, strings.TrimPrefix(f.EmailDomain, "@"))
+	}
+	for _, key := range sortedKeys(f.Labels) {
+		query.Add(engineerFilterLabels, key+"="+f.Labels[key])
+	}
+	if f.TeamType != "" && f.TeamId != "" {
+		query.Set(engineerFilterTeam, f.TeamType+"/"+f.TeamId)
+	}
+	return query
+}
+
+// GetEngineersWithFilter - Returns the engineers matching filter, sorted by
+// email then ID so the result is stable across calls. The API filters the
+// list, servers that predate filtering ignore the query parameters: the
+// filters they did not report as applied are applied locally.
+func (c *Client) GetEngineersWithFilter(filter EngineerFilter) ([]Engineer, error) {
+	endpoint := fmt.Sprintf("%s/engineers", c.HostURL)
+	if query := filter.query(); len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, res, err := c.doRequestWithResponse(req)
+	if err != nil {
+		return nil, err
+	}
+
+	engineers := []Engineer{}
+	err = json.Unmarshal(body, &engineers)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only keep the filters the API did not apply
+	local := filter
+	for _, applied := range strings.Split(res.Header.Get(appliedFiltersHeader), ",") {
+		switch strings.TrimSpace(applied) {
+		case engineerFilterEmailDomain:
+			local.EmailDomain = ""
+		case engineerFilterLabels:
+			local.Labels = nil
+		case engineerFilterTeam:
+			local.TeamType, local.TeamId = "", ""
+		}
+	}
+
+	var team *Team
+	if local.TeamType != "" && local.TeamId != "" {
+		team, err = c.GetTeam(local.TeamType, local.TeamId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	matches := []Engineer{}
+	for _, engineer := range engineers {
+		if local.Matches(engineer) && (team == nil || team.HasEngineer(engineer.Id)) {
+			matches = append(matches, engineer)
+		}
+	}
+
+	sortEngineers(matches)
+
+	return matches, nil
+}
+
+// sortEngineers sorts engineers by email, compared case-insensitively, then
+// by ID, so lists built from API responses are stable across calls.
+func sortEngineers(engineers []Engineer) {
+	sort.SliceStable(engineers, func(i, j int) bool {
+		left, right := strings.ToLower(engineers[i].Email), strings.ToLower(engineers[j].Email)
+		if left != right {
+			return left < right
+		}
+		return engineers[i].Id < engineers[j].Id
+	})
+}
+
 // GetEngineer - Returns specific engineer (no auth required)
-func (c *Client) GetEngineer(engineerId string) (engineerDataSourceModel, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/engineers/id/%s", c.HostURL, engineerId), nil)
+func (c *Client) GetEngineer(engineerId string) (Engineer, error) {
+	return c.GetEngineerIfNoneMatch(engineerId, "")
+}
+
+// GetEngineerIfNoneMatch - Returns specific engineer unless its revision
+// still matches the given ETag, in which case ErrNotModified is returned
+func (c *Client) GetEngineerIfNoneMatch(engineerId string, revision string) (Engineer, error) {
+	return c.getEngineer(context.Background(), engineerId, revision)
+}
+
+// getEngineer is GetEngineerIfNoneMatch bounded by ctx, an empty revision
+// always fetches the engineer.
+func (c *Client) getEngineer(ctx context.Context, engineerId string, revision string) (Engineer, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/engineers/id/%s", c.HostURL, engineerId), nil)
 	if err != nil {
-		return engineerDataSourceModel{}, err
+		return Engineer{}, err
+	}
+	if revision != "" {
+		req.Header.Set("If-None-Match", revision)
 	}
 
-	body, err := c.doRequest(req)
+	body, res, err := c.doRequestWithResponse(req)
 	if err != nil {
-		return engineerDataSourceModel{}, err
+		return Engineer{}, err
 	}
 
-	engineer := engineerDataSourceModel{}
+	engineer := Engineer{}
 	err = json.Unmarshal(body, &engineer)
 	if err != nil {
-		return engineerDataSourceModel{}, err
+		return Engineer{}, err
 	}
+	engineer.Revision = res.Header.Get("ETag")
 
 	return engineer, nil
 }
 
 
 
-// CreateEngineer - Create new engineer
-func (c *Client) CreateEngineer(engineer engineerResourceModel) (*engineerDataSourceModel, error) {
-	//Cannot marshal variables that are types.String
-	// We have to convert the code into regular go types (string)
-	// so that it can be marshalled
-	apiEngineer := engineerDataSourceModel{
-		Name: engineer.Name.ValueString(),
-		Email: engineer.Email.ValueString(),
+// GetEngineersByName - Returns every engineer with the given name (no auth required)
+func (c *Client) GetEngineersByName(name string) ([]Engineer, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/engineers/name/%s", c.HostURL, url.PathEscape(name)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if errors.Is(err, ErrNotFound) {
+		return []Engineer{}, nil
 	}
-	rb, err := json.Marshal(apiEngineer)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/engineers", c.HostURL), strings.NewReader(string(rb)))
+	engineers := []Engineer{}
+	err = json.Unmarshal(body, &engineers)
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.doRequest(req)
+	return engineers, nil
+}
+
+// GetEngineersByEmail - Returns every engineer with the given email,
+// compared case-insensitively like the API does
+func (c *Client) GetEngineersByEmail(email string) ([]Engineer, error) {
+	return c.getEngineersByEmail(context.Background(), email)
+}
+
+// getEngineersByEmail is GetEngineersByEmail bounded by ctx.
+func (c *Client) getEngineersByEmail(ctx context.Context, email string) ([]Engineer, error) {
+	engineers, err := c.getEngineers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := []Engineer{}
+	for _, engineer := range engineers {
+		if strings.EqualFold(engineer.Email, email) {
+			matches = append(matches, engineer)
+		}
+	}
+
+	return matches, nil
+}
+
+// CreateEngineer - Create new engineer. When the API provisions the engineer
+// asynchronously it answers 202 Accepted with an operation, which is polled
+// until the engineer is ready, the operation failed or ctx is done
+func (c *Client) CreateEngineer(ctx context.Context, engineer Engineer) (*Engineer, error) {
+	rb, err := json.Marshal(engineer)
 	if err != nil {
 		return nil, err
 	}
 
-	newEngineer := engineerDataSourceModel{}
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/engineers", c.HostURL), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, res, err := c.doRequestWithResponse(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode == http.StatusAccepted {
+		operation := Operation{}
+		err = json.Unmarshal(body, &operation)
+		if err != nil {
+			return nil, err
+		}
+		if operation.Link == "" {
+			operation.Link = res.Header.Get("Location")
+		}
+
+		return c.waitForEngineer(ctx, operation)
+	}
+
+	newEngineer := Engineer{}
 	err = json.Unmarshal(body, &newEngineer)
 	if err != nil {
 		return nil, err
 	}
 
+	newEngineer.Revision = res.Header.Get("ETag")
+
 	return &newEngineer, nil
 }
 
 // UpdateEngineer - Updates an engineer
-func (c *Client) UpdateEngineer(engineerID string, engineer engineerResourceModel) (*engineerDataSourceModel, error) {
-	// Cannot marshal variables that are types.String
-	// We have to convert the code into regular go types (string)
-	// so that it can be marshalled
-	apiEngineer := engineerDataSourceModel{
-		Name: engineer.Name.ValueString(),
-		Email: engineer.Email.ValueString(),
-	}
-	rb, err := json.Marshal(apiEngineer)
+func (c *Client) UpdateEngineer(engineerID string, engineer Engineer) (*Engineer, error) {
+	return c.updateEngineer(context.Background(), engineerID, engineer)
+}
+
+// updateEngineer is UpdateEngineer bounded by ctx.
+func (c *Client) updateEngineer(ctx context.Context, engineerID string, engineer Engineer) (*Engineer, error) {
+	rb, err := json.Marshal(engineer)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/engineers/%s", c.HostURL, engineerID), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/engineers/%s", c.HostURL, engineerID), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.doRequest(req)
+	body, res, err := c.doRequestWithResponse(req)
 	if err != nil {
 		return nil, err
 	}
 
 
-	newEngineer := engineerDataSourceModel{}
+	newEngineer := Engineer{}
 	err = json.Unmarshal(body, &newEngineer)
 	if err != nil {
 		return nil, err
 	}
 
+	newEngineer.Revision = res.Header.Get("ETag")
+
 	return &newEngineer, nil
 }
 
 // Delete Engineer - Deletes an engineer
 func (c *Client) DeleteEngineer(engineerID string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/engineers/%s", c.HostURL, engineerID), nil)
+	return c.deleteEngineer(context.Background(), engineerID)
+}
+
+// deleteEngineer is DeleteEngineer bounded by ctx.
+func (c *Client) deleteEngineer(ctx context.Context, engineerID string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/engineers/%s", c.HostURL, engineerID), nil)
 	if err != nil {
 		return err
 	}
@@ -147,9 +411,70 @@ func (c *Client) DeleteEngineer(engineerID string) error {
 	return nil
 }
 
+// CreateEngineers - Creates several engineers in batches. The returned slices
+// are indexed like the input, failed items have a nil engineer and a non-nil error.
+func (c *Client) CreateEngineers(ctx context.Context, engineers []Engineer) ([]*Engineer, []error) {
+	created := make([]*Engineer, len(engineers))
+	errs := runBatched(len(engineers), func(i int) error {
+		engineer, err := c.CreateEngineer(ctx, engineers[i])
+		created[i] = engineer
+		return err
+	})
 
+	return created, errs
+}
 
+// UpdateEngineers - Updates several engineers, identified by their Id, in batches.
+// The returned slices are indexed like the input.
+func (c *Client) UpdateEngineers(ctx context.Context, engineers []Engineer) ([]*Engineer, []error) {
+	updated := make([]*Engineer, len(engineers))
+	errs := runBatched(len(engineers), func(i int) error {
+		engineer, err := c.updateEngineer(ctx, engineers[i].Id, engineers[i])
+		updated[i] = engineer
+		return err
+	})
 
+	return updated, errs
+}
 
+// DeleteEngineers - Deletes several engineers in batches. The returned slice
+// is indexed like the input.
+func (c *Client) DeleteEngineers(ctx context.Context, engineerIDs []string) []error {
+	return runBatched(len(engineerIDs), func(i int) error {
+		return c.deleteEngineer(ctx, engineerIDs[i])
+	})
+}
 
+// runBatched calls fn for every index in [0, n), engineerBatchSize indexes at
+// a time with at most engineerBatchParallelism calls running concurrently.
+// Once a batch has failed the remaining batches are skipped so a broken API
+// does not receive hundreds of doomed requests.
+func runBatched(n int, fn func(i int) error) []error {
+	errs := make([]error, n)
+	sem := make(chan struct{}, engineerBatchParallelism)
+
+	for start := 0; start < n; start += engineerBatchSize {
+		end := min(start+engineerBatchSize, n)
+
+		var wg sync.WaitGroup
+		for i := start; i < end; i++ {
+			wg.Add(1)
+			sem <- struct{}{}
+			go func(i int) {
+				defer wg.Done()
+				defer func() { <-sem }()
+				errs[i] = fn(i)
+			}(i)
+		}
+		wg.Wait()
+
+		if errors.Join(errs[start:end]...) != nil {
+			for i := end; i < n; i++ {
+				errs[i] = errBatchAborted
+			}
+			break
+		}
+	}
 
+	return errs
+}
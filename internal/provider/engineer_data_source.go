@@ -1,127 +1,235 @@
 package provider
 
 import (
-        "context"
-        "fmt"
-
-        "github.com/hashicorp/terraform-plugin-framework/datasource"
-        "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
-        "github.com/hashicorp/terraform-plugin-framework/path"
-        // "github.com/hashicorp/terraform-plugin-framework/diag"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-        _ datasource.DataSource = &engineerDataSource{}
-        _ datasource.DataSourceWithConfigure = &engineerDataSource{}
+	_ datasource.DataSource                     = &engineerDataSource{}
+	_ datasource.DataSourceWithConfigure        = &engineerDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &engineerDataSource{}
 )
 
 // helper function to simplify the provider implementation
 func NewEngineerDataSource() datasource.DataSource {
-        return &engineerDataSource{}
+	return &engineerDataSource{}
 }
 
 // engineerDataSource defines the data source implementation.
-type engineerDataSource struct{
-        client *Client
+type engineerDataSource struct {
+	client *Client
 }
 
 // engineerDataSourceModel defines the data model for the data source.
 type engineerDataSourceModel struct {
-        //Engineer engineerModel `tfsdk:"engineer"`
-        Name    string `tfsdk:"name"`
-        Id      string `tfsdk:"id"`
-        Email   string `tfsdk:"email"`
+	Name      types.String `tfsdk:"name"`
+	Id        types.String `tfsdk:"id"`
+	Email     EmailValue   `tfsdk:"email"`
+	Role      types.String `tfsdk:"role"`
+	Seniority types.String `tfsdk:"seniority"`
+	Skills    types.Set    `tfsdk:"skills"`
+	Location  types.String `tfsdk:"location"`
+	Timezone  types.String `tfsdk:"timezone"`
+	StartDate types.String `tfsdk:"start_date"`
+	Active    types.Bool   `tfsdk:"active"`
+	Labels    types.Map    `tfsdk:"labels"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
 }
 
-// engineerModel maps engineer schema data
-// type engineerModel struct {
-//         Name    string `tfsdk:"name"`
-//         Id      string `tfsdk:"id"`
-//         Email   string `tfsdk:"email"`
-// }
-
 // Metadata returns the data source type name.
 func (d *engineerDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-        resp.TypeName = req.ProviderTypeName + "_engineer"
+	resp.TypeName = req.ProviderTypeName + "_engineer"
 }
 
-
 // Configure adds the provider configured client to the data source.
 func (d *engineerDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-        // Add a nil check when handling ProviderData because Terraform
-        // sets that data after it calls the ConfigureProvider RPC.
-        if req.ProviderData == nil {
-                return
-        }
-
-        client, ok := req.ProviderData.(*Client)
-        if !ok {
-                resp.Diagnostics.AddError(
-                        "Unexpected Data Source Configure Type",
-                        fmt.Sprintf("Expected Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-                        )
-
-                return
-        }
-
-        d.client = client
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
 }
 
-
 // Schema defines the schema for the data source.
 func (d *engineerDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-        resp.Schema = schema.Schema{
-                Attributes: map[string]schema.Attribute{
-                        "name": schema.StringAttribute{
-                                Required: true,    
-                        },
-                        "id": schema.StringAttribute{
-                                Computed: true,
-                        },
-                        "email": schema.StringAttribute{
-                                Required: true,
-                        },
-                },
-        }
+	attributes := engineerDataSourceAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Description: "ID of the engineer to look up.",
+		Optional:    true,
+		Computed:    true,
+	}
+	attributes["name"] = schema.StringAttribute{
+		Description: "Name of the engineer to look up, it must match a single engineer.",
+		Optional:    true,
+		Computed:    true,
+	}
+	attributes["email"] = schema.StringAttribute{
+		Description: "Email of the engineer to look up, compared case-insensitively.",
+		CustomType:  EmailType{},
+		Optional:    true,
+		Computed:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Looks up a single engineer by exactly one of id, name or email.",
+		Attributes:  attributes,
+	}
+}
+
+// ConfigValidators requires exactly one lookup attribute.
+func (d *engineerDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+			path.MatchRoot("email"),
+		),
+	}
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (d *engineerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-        var state engineerDataSourceModel
-
-        // Get the "id" attribute from the configuration
-        var id string
-        diags := req.Config.GetAttribute(ctx, path.Root("id"), &id)
-        if diags.HasError() {
-                resp.Diagnostics.AddError(
-                        "Unable to Read DevOps engineer",
-                        "The 'id' attribute is missing or invalid",
-                        )
-                return
-        }
-
-        // // Convert attribute to a string
-        // id := idAttr.(string)
-
-        engineer, err := d.client.GetEngineer(id)
-        if err != nil {
-                resp.Diagnostics.AddError(
-                        "Unable to Read DevOps engineer",
-                        err.Error(),
-                        )
-                return
-        }
-
-        // Map the engineer data to the state model
-        state.Name =    engineer.Name
-        state.Id =      engineer.Id
-        state.Email =   engineer.Email
-
-        // Set state
-        diags = resp.State.Set(ctx, &state)
-        resp.Diagnostics.Append(diags...)
-        if resp.Diagnostics.HasError() {
-                return
-        }
+	var config engineerDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	engineer, diags := d.lookup(config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map the engineer data to the state model
+	var state engineerDataSourceModel
+	resp.Diagnostics.Append(state.refresh(ctx, engineer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Keep the configured email as written, the API may have another case
+	if !config.Email.IsNull() {
+		state.Email = config.Email
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// lookup returns the single engineer selected by the configured id, name
+// or email.
+func (d *engineerDataSource) lookup(config engineerDataSourceModel) (*Engineer, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var (
+		by        string
+		value     string
+		engineers []Engineer
+		err       error
+	)
+	switch {
+	case !config.Id.IsNull():
+		by, value = "id", config.Id.ValueString()
+		var engineer Engineer
+		engineer, err = d.client.GetEngineer(value)
+		if errors.Is(err, ErrNotFound) {
+			err = nil
+		} else if err == nil {
+			engineers = []Engineer{engineer}
+		}
+	case !config.Name.IsNull():
+		by, value = "name", config.Name.ValueString()
+		engineers, err = d.client.GetEngineersByName(value)
+	default:
+		by, value = "email", config.Email.ValueString()
+		engineers, err = d.client.GetEngineersByEmail(value)
+	}
+
+	if err != nil {
+		diags.AddError(
+			"Unable to Read DevOps engineer",
+			fmt.Sprintf("Could not look up engineer by %s %q, unexpected error: %s", by, value, err),
+		)
+		return nil, diags
+	}
+
+	switch len(engineers) {
+	case 0:
+		diags.AddAttributeError(
+			path.Root(by),
+			"Engineer Not Found",
+			fmt.Sprintf("No engineer with %s %q exists.", by, value),
+		)
+		return nil, diags
+	case 1:
+		return &engineers[0], diags
+	}
+
+	ids := make([]string, len(engineers))
+	for i, engineer := range engineers {
+		ids[i] = engineer.Id
+	}
+	diags.AddAttributeError(
+		path.Root(by),
+		"Multiple Engineers Found",
+		fmt.Sprintf("%d engineers have %s %q: %s. Look the engineer up by id instead.", len(engineers), by, value, strings.Join(ids, ", ")),
+	)
+	return nil, diags
+}
+
+// refresh overwrites the model with the API representation of an engineer.
+func (m *engineerDataSourceModel) refresh(ctx context.Context, engineer *Engineer) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	m.Id = types.StringValue(engineer.Id)
+	m.Name = types.StringValue(engineer.Name)
+	m.Email = NewEmailValue(engineer.Email)
+	m.Role = types.StringValue(engineer.Role)
+	m.Seniority = types.StringValue(engineer.Seniority)
+	m.Location = types.StringValue(engineer.Location)
+	m.Timezone = types.StringValue(engineer.Timezone)
+	m.StartDate = types.StringValue(engineer.StartDate)
+	m.Active = types.BoolValue(engineer.IsActive())
+	m.CreatedAt = timestampValue(engineer.CreatedAt)
+	m.UpdatedAt = timestampValue(engineer.UpdatedAt)
+
+	skills := engineer.Skills
+	if skills == nil {
+		skills = []string{}
+	}
+	m.Skills, d = types.SetValueFrom(ctx, types.StringType, skills)
+	diags.Append(d...)
+
+	labels := engineer.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	m.Labels, d = types.MapValueFrom(ctx, types.StringType, labels)
+	diags.Append(d...)
+
+	return diags
 }
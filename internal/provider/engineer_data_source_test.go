@@ -1,25 +1,59 @@
 package provider
 
 import (
-    "testing"
+	"regexp"
+	"testing"
 
-    "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
+const testAccEngineerDataSourceEngineer = `
+resource "devops_engineer" "test" {
+  name  = "testie_mctestface"
+  email = "testie@liatriolife.com"
+}
+`
+
 func TestEngineerDataSource(t *testing.T) {
-    resource.Test(t, resource.TestCase{
-        ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
-        Steps: []resource.TestStep{
-            // Read testing
-            {
-                Config: providerConfig + `data "devops_engineer" "test" {}`,
-                Check: resource.ComposeAggregateTestCheckFunc(
-                    // Verify number of coffees returned
-                    resource.TestCheckResourceAttr("data.devops_engineer.test", "name", "testie_mctestface"),
-                    // Verify the first coffee to ensure all attributes are set
-                    resource.TestCheckResourceAttr("data.devops_engineer.test", "email", "testie@liatriolife.com"),
-                ),
-            },
-        },
-    })
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing by id, name and email
+			{
+				Config: providerConfig + testAccEngineerDataSourceEngineer + `
+data "devops_engineer" "by_id" {
+  id = devops_engineer.test.id
+}
+
+data "devops_engineer" "by_name" {
+  name = devops_engineer.test.name
+}
+
+data "devops_engineer" "by_email" {
+  email = "Testie@LiatrioLife.com"
+
+  depends_on = [devops_engineer.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.devops_engineer.by_id", "name", "testie_mctestface"),
+					resource.TestCheckResourceAttr("data.devops_engineer.by_id", "email", "testie@liatriolife.com"),
+					resource.TestCheckResourceAttrPair("data.devops_engineer.by_name", "id", "devops_engineer.test", "id"),
+					resource.TestCheckResourceAttr("data.devops_engineer.by_name", "email", "testie@liatriolife.com"),
+					resource.TestCheckResourceAttrPair("data.devops_engineer.by_email", "id", "devops_engineer.test", "id"),
+					resource.TestCheckResourceAttr("data.devops_engineer.by_email", "name", "testie_mctestface"),
+				),
+			},
+			// Exactly one lookup attribute must be set
+			{
+				Config:      providerConfig + `data "devops_engineer" "test" {}`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			// Unknown engineers are reported as not found
+			{
+				Config:      providerConfig + `data "devops_engineer" "test" { email = "nobody@liatriolife.com" }`,
+				ExpectError: regexp.MustCompile(`Engineer Not Found`),
+			},
+		},
+	})
 }
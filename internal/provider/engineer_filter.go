@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// engineerFilterModel maps the engineer filters shared by the
+// devops_engineers data source and the devops_engineer list resource.
+// Labels and team are framework values since they can be unknown at plan
+// time, which Go maps and pointers cannot represent.
+type engineerFilterModel struct {
+	NameRegex   types.String `tfsdk:"name_regex"`
+	EmailDomain types.String `tfsdk:"email_domain"`
+	Labels      types.Map    `tfsdk:"labels"`
+	Team        types.Object `tfsdk:"team"`
+}
+
+// engineersTeamFilterModel maps the team membership filter.
+type engineersTeamFilterModel struct {
+	Type types.String `tfsdk:"type"`
+	Id   types.String `tfsdk:"id"`
+}
+
+// engineerFilterAttributes returns the attributes of engineerFilterModel.
+// List resource schemas take them as they are, their attributes implement
+// the same framework interface as data source ones.
+func engineerFilterAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name_regex": schema.StringAttribute{
+			Description: "Regular expression, in Go syntax, the engineer name must match.",
+			Optional:    true,
+		},
+		"email_domain": schema.StringAttribute{
+			Description: "Domain the engineer email must belong to, compared case-insensitively.",
+			Optional:    true,
+		},
+		"labels": schema.MapAttribute{
+			Description: "Labels the engineer must carry with exactly these values.",
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"team": schema.SingleNestedAttribute{
+			Description: "Team the engineer must be a member of.",
+			Optional:    true,
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					Description: "Type of the team, either `dev` or `ops`.",
+					Required:    true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(teamTypes...),
+					},
+				},
+				"id": schema.StringAttribute{
+					Description: "ID of the team.",
+					Required:    true,
+				},
+			},
+		},
+	}
+}
+
+// validateEngineerFilter rejects name regular expressions that do not
+// compile.
+func validateEngineerFilter(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	var nameRegex types.String
+	diags := config.GetAttribute(ctx, path.Root("name_regex"), &nameRegex)
+	if diags.HasError() || nameRegex.IsNull() || nameRegex.IsUnknown() {
+		return diags
+	}
+
+	_, regexDiags := compileNameRegex(nameRegex)
+	diags.Append(regexDiags...)
+	return diags
+}
+
+// known reports whether every filter is known, filters depending on
+// values only known after apply cannot be applied before.
+func (m *engineerFilterModel) known() bool {
+	if m.NameRegex.IsUnknown() || m.EmailDomain.IsUnknown() || m.Labels.IsUnknown() || m.Team.IsUnknown() {
+		return false
+	}
+	for _, label := range m.Labels.Elements() {
+		if label.IsUnknown() {
+			return false
+		}
+	}
+	for _, attribute := range m.Team.Attributes() {
+		if attribute.IsUnknown() {
+			return false
+		}
+	}
+	return true
+}
+
+// filter returns the EngineerFilter the model describes, which must be
+// known.
+func (m *engineerFilterModel) filter(ctx context.Context) (EngineerFilter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	filter := EngineerFilter{
+		EmailDomain: m.EmailDomain.ValueString(),
+	}
+	if !m.NameRegex.IsNull() {
+		filter.NameRegex, diags = compileNameRegex(m.NameRegex)
+		if diags.HasError() {
+			return filter, diags
+		}
+	}
+	if !m.Labels.IsNull() {
+		diags.Append(m.Labels.ElementsAs(ctx, &filter.Labels, false)...)
+	}
+	if !m.Team.IsNull() {
+		var team engineersTeamFilterModel
+		diags.Append(m.Team.As(ctx, &team, basetypes.ObjectAsOptions{})...)
+		filter.TeamType = team.Type.ValueString()
+		filter.TeamId = team.Id.ValueString()
+	}
+
+	return filter, diags
+}
+
+// compileNameRegex compiles the name_regex filter.
+func compileNameRegex(nameRegex types.String) (*regexp.Regexp, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	compiled, err := regexp.Compile(nameRegex.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("name_regex"),
+			"Invalid Name Regular Expression",
+			"The name_regex value is not a valid regular expression: "+err.Error(),
+		)
+	}
+
+	return compiled, diags
+}
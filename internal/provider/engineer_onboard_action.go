@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultOnboardingTimeout bounds the onboarding workflow when timeout is
+// not configured.
+const defaultOnboardingTimeout = 10 * time.Minute
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action                   = &engineerOnboardAction{}
+	_ action.ActionWithConfigure      = &engineerOnboardAction{}
+	_ action.ActionWithValidateConfig = &engineerOnboardAction{}
+)
+
+// NewEngineerOnboardAction is a helper function to simplify the provider implementation.
+func NewEngineerOnboardAction() action.Action {
+	return &engineerOnboardAction{}
+}
+
+// engineerOnboardAction runs the onboarding workflow of an engineer.
+type engineerOnboardAction struct {
+	client *Client
+}
+
+// engineerOnboardActionModel maps the action schema data.
+type engineerOnboardActionModel struct {
+	EngineerId types.String `tfsdk:"engineer_id"`
+	Steps      types.Set    `tfsdk:"steps"`
+	Timeout    types.String `tfsdk:"timeout"`
+}
+
+// Metadata returns the action type name.
+func (a *engineerOnboardAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_engineer_onboard"
+}
+
+// Configure adds the provider configured client to the action.
+func (a *engineerOnboardAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = client
+}
+
+// Schema defines the schema for the action.
+func (a *engineerOnboardAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs the onboarding workflow of an engineer, e.g. account creation and welcome email, " +
+			"and reports its progress until it is done. Typically triggered after the engineer is created.",
+		Attributes: map[string]schema.Attribute{
+			"engineer_id": schema.StringAttribute{
+				Description: "ID of the engineer to onboard.",
+				Required:    true,
+			},
+			"steps": schema.SetAttribute{
+				Description: "Onboarding steps to run, e.g. `accounts` or `welcome_email`. Defaults to every step.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"timeout": schema.StringAttribute{
+				Description: "How long to wait for the workflow, as a Go duration such as `5m`. Defaults to `10m`.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig rejects timeouts that are not positive durations.
+func (a *engineerOnboardAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var timeout types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("timeout"), &timeout)...)
+	if resp.Diagnostics.HasError() || timeout.IsUnknown() {
+		return
+	}
+
+	_, diags := onboardingTimeout(timeout)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Invoke starts the onboarding workflow and streams its progress messages
+// until it completes.
+func (a *engineerOnboardAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config engineerOnboardActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := onboardingTimeout(config.Timeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var steps []string
+	if !config.Steps.IsNull() {
+		resp.Diagnostics.Append(config.Steps.ElementsAs(ctx, &steps, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	engineerID := config.EngineerId.ValueString()
+	operation, err := a.client.StartEngineerOnboarding(ctx, engineerID, steps)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error starting engineer onboarding",
+			"Could not start onboarding of engineer "+engineerID+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: "Started onboarding of engineer " + engineerID,
+	})
+
+	// Every poll returns the whole progress log, only send what is new.
+	sent := 0
+	_, err = a.client.waitForOperation(ctx, *operation, func(operation Operation) {
+		for ; sent < len(operation.Messages); sent++ {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: operation.Messages[sent],
+			})
+		}
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error onboarding engineer",
+			"Onboarding of engineer "+engineerID+" did not complete: "+err.Error(),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: "Finished onboarding of engineer " + engineerID,
+	})
+}
+
+// onboardingTimeout parses the configured timeout, defaulting to
+// defaultOnboardingTimeout when it is null.
+func onboardingTimeout(value types.String) (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if value.IsNull() {
+		return defaultOnboardingTimeout, diags
+	}
+
+	timeout, err := time.ParseDuration(value.ValueString())
+	if err != nil || timeout <= 0 {
+		diags.AddAttributeError(
+			path.Root("timeout"),
+			"Invalid Onboarding Timeout",
+			fmt.Sprintf("Expected a positive Go duration such as 5m. Got: %q", value.ValueString()),
+		)
+	}
+
+	return timeout, diags
+}
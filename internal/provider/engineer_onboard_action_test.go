@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// testAccEngineerOnboardConfig returns an engineer onboarded after create
+// with the given action config attributes.
+func testAccEngineerOnboardConfig(email string, actionConfig string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "devops_engineer" "onboarded" {
+  name  = "onboarded"
+  email = %q
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.devops_engineer_onboard.onboarded]
+    }
+  }
+}
+
+action "devops_engineer_onboard" "onboarded" {
+  config {
+    engineer_id = devops_engineer.onboarded.id
+%s
+  }
+}
+`, email, actionConfig)
+}
+
+// testAccCheckOnboarded checks that the fake DevOps API ran exactly one
+// onboarding of the engineer with the given id, through steps, to the end.
+func testAccCheckOnboarded(steps ...string) func(string) error {
+	return func(id string) error {
+		operations := testAccAPI.onboardings(id)
+		if len(operations) != 1 {
+			return fmt.Errorf("expected 1 onboarding of engineer %s, got %d", id, len(operations))
+		}
+
+		operation := operations[0]
+		if !slices.Equal(operation.Steps, steps) {
+			return fmt.Errorf("expected onboarding steps %v, got %v", steps, operation.Steps)
+		}
+		for _, step := range steps {
+			if !slices.Contains(operation.Messages, "Completed "+step) {
+				return fmt.Errorf("onboarding step %s did not run, messages: %v", step, operation.Messages)
+			}
+		}
+		if operation.Status != operationStatusReady {
+			return fmt.Errorf("expected onboarding to be %s, got %s", operationStatusReady, operation.Status)
+		}
+		return nil
+	}
+}
+
+func TestEngineerOnboardAction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Onboarding runs once the engineer is created
+			{
+				Config: testAccEngineerOnboardConfig("onboarded@liatriolife.com", `
+    steps = ["accounts", "welcome_email"]
+`),
+				Check: resource.TestCheckResourceAttrWith("devops_engineer.onboarded", "id",
+					testAccCheckOnboarded("accounts", "welcome_email"),
+				),
+			},
+			// Invalid timeouts are rejected at plan time
+			{
+				Config: testAccEngineerOnboardConfig("onboarded@liatriolife.com", `
+    timeout = "soon"
+`),
+				ExpectError: regexp.MustCompile(`Invalid Onboarding Timeout`),
+			},
+		},
+	})
+}
+
+func TestEngineerOnboardAction_failedStep(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEngineerOnboardConfig("onboarding.failed@liatriolife.com", `
+    steps = ["accounts", "`+fakeFailingStep+`"]
+`),
+				ExpectError: regexp.MustCompile(fakeFailingStep + ` failed`),
+			},
+		},
+	})
+}
+
+func TestEngineerOnboardAction_timeout(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEngineerOnboardConfig("onboarding.stuck@liatriolife.com", `
+    steps   = ["`+fakeStuckStep+`"]
+    timeout = "2s"
+`),
+				ExpectError: regexp.MustCompile(`timed out waiting for operation`),
+			},
+		},
+	})
+}
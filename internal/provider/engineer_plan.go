@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// engineerPlanCache holds what was learnt while planning devops_engineer and
+// devops_engineers instances. Terraform starts a new provider process for
+// every plan and apply, so the cache never outlives a single run.
+type engineerPlanCache struct {
+	mu sync.Mutex
+
+	// engineers is the engineer list loaded from the API on first use, so
+	// planning hundreds of engineers costs a single list call.
+	engineers []Engineer
+	loaded    bool
+
+	// claims maps a lower-cased email to the engineer planned with it.
+	claims map[string]engineerClaimant
+}
+
+// engineerClaimant is an engineer of the configuration planned with an
+// email, by a devops_engineer resource or a devops_engineers entry.
+type engineerClaimant struct {
+	// Key tells claimants apart, see planClaim.
+	Key string
+	// Description names the engineer in diagnostics.
+	Description string
+}
+
+// newEngineerPlanCache returns an empty engineerPlanCache.
+func newEngineerPlanCache() *engineerPlanCache {
+	return &engineerPlanCache{
+		claims: map[string]engineerClaimant{},
+	}
+}
+
+// Engineers returns every engineer known to the API, loading them on first use.
+func (p *engineerPlanCache) Engineers(c *Client) ([]Engineer, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.loaded {
+		engineers, err := c.GetEngineers()
+		if err != nil {
+			return nil, err
+		}
+		p.engineers = engineers
+		p.loaded = true
+	}
+
+	return p.engineers, nil
+}
+
+// Claim records that claimant is planned with email. It returns the
+// previous claimant and false when a different engineer of the
+// configuration already claimed the same email.
+func (p *engineerPlanCache) Claim(email string, claimant engineerClaimant) (engineerClaimant, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key := strings.ToLower(email)
+	if previous, ok := p.claims[key]; ok && previous.Key != claimant.Key {
+		return previous, false
+	}
+	p.claims[key] = claimant
+
+	return claimant, true
+}
+
+// privateClaimKey is the private state key holding the planClaim of a
+// resource.
+const privateClaimKey = "plan_claim"
+
+// planClaim identifies a resource when it claims emails. Resources cannot be
+// told apart by their attributes, two new engineers may well share a name,
+// and a replaced resource is planned twice in the same run: once with its
+// prior state, then again as a creation with a null prior state. The claim
+// is kept in private state, which Terraform carries from the first plan to
+// the second, so both plans claim with the same key.
+type planClaim struct {
+	Key string `json:"key"`
+
+	// EngineerId is the engineer the resource managed when last planned
+	// with a prior state. Its replacement may take over that engineer's
+	// email without a conflict.
+	EngineerId string `json:"engineer_id,omitempty"`
+}
+
+// loadPlanClaim returns the claim of the resource planned with the given
+// private state, creating it on first use, and stores it back. engineerID is
+// the engineer of the prior state, empty when there is none.
+func loadPlanClaim(ctx context.Context, prior privateStateGetter, planned privateStateSetter, engineerID string) (planClaim, diag.Diagnostics) {
+	var claim planClaim
+
+	value, diags := prior.GetKey(ctx, privateClaimKey)
+	if diags.HasError() {
+		return claim, diags
+	}
+	if len(value) > 0 {
+		// A claim that cannot be decoded is replaced by a new one.
+		_ = json.Unmarshal(value, &claim)
+	}
+
+	if claim.Key == "" {
+		key, err := uuid.GenerateUUID()
+		if err != nil {
+			diags.AddError(
+				"Error planning engineer",
+				"Could not generate an email claim key, unexpected error: "+err.Error(),
+			)
+			return claim, diags
+		}
+		claim.Key = key
+	}
+	if engineerID != "" {
+		claim.EngineerId = engineerID
+	}
+
+	value, err := json.Marshal(claim)
+	if err != nil {
+		diags.AddError(
+			"Error planning engineer",
+			"Could not encode the email claim, unexpected error: "+err.Error(),
+		)
+		return claim, diags
+	}
+	diags.Append(planned.SetKey(ctx, privateClaimKey, value)...)
+
+	return claim, diags
+}
@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestEngineerResource_planReplacement plans a replaced engineer the way
+// Terraform does, once with its prior state and once more as a creation,
+// which must not find its own email in use.
+func TestEngineerResource_planReplacement(t *testing.T) {
+	ctx := context.Background()
+
+	engineer := testCreateEngineer(t, Engineer{Name: "replaced", Email: "replaced@liatriolife.com"})
+
+	server := testProviderServer(t)
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	objectType := schemaResp.ResourceSchemas["devops_engineer"].ValueType().(tftypes.Object)
+
+	config := testObjectValue(t, objectType, map[string]tftypes.Value{
+		"name":  tftypes.NewValue(tftypes.String, engineer.Name),
+		"email": tftypes.NewValue(tftypes.String, engineer.Email),
+	})
+	prior := testObjectValue(t, objectType, map[string]tftypes.Value{
+		"id":    tftypes.NewValue(tftypes.String, engineer.Id),
+		"name":  tftypes.NewValue(tftypes.String, engineer.Name),
+		"email": tftypes.NewValue(tftypes.String, engineer.Email),
+	})
+	null := tftypes.NewValue(objectType, nil)
+
+	plan := func(prior tftypes.Value, priorPrivate []byte) *tfprotov6.PlanResourceChangeResponse {
+		t.Helper()
+		resp, err := server.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+			TypeName:         "devops_engineer",
+			PriorState:       testDynamicValue(t, objectType, prior),
+			ProposedNewState: testDynamicValue(t, objectType, config),
+			Config:           testDynamicValue(t, objectType, config),
+			PriorPrivate:     priorPrivate,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp
+	}
+
+	// -replace plans the instance with its prior state, then as a creation
+	// carrying the private state of the first plan.
+	first := plan(prior, nil)
+	if len(first.Diagnostics) > 0 {
+		t.Fatalf("unexpected diagnostics planning the prior state: %v", first.Diagnostics)
+	}
+	second := plan(null, first.PlannedPrivate)
+	if len(second.Diagnostics) > 0 {
+		t.Fatalf("unexpected diagnostics planning the replacement: %v", second.Diagnostics)
+	}
+
+	// A tainted instance is only planned as a creation, which cannot tell its
+	// own engineer apart and warns instead of failing.
+	server = testProviderServer(t)
+	tainted := plan(null, nil)
+	if len(tainted.Diagnostics) != 1 || tainted.Diagnostics[0].Severity != tfprotov6.DiagnosticSeverityWarning {
+		t.Fatalf("expected a single warning planning the tainted instance, got: %v", tainted.Diagnostics)
+	}
+}
+
+func TestEngineerResource_replace(t *testing.T) {
+	config := providerConfig + `
+resource "devops_engineer" "test" {
+  name  = "phoenix"
+  email = "phoenix@liatriolife.com"
+}
+`
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("devops_engineer.test", "id", func(value string) error {
+						id = value
+						return nil
+					}),
+				),
+			},
+			{
+				Config: config,
+				Taint:  []string{"devops_engineer.test"},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("devops_engineer.test", "id", func(value string) error {
+						if value == id {
+							return fmt.Errorf("engineer %s was not replaced", id)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+// testProviderServer returns a provider server configured against
+// testAccAPI. Every server plans with an empty engineerPlanCache, like a new
+// Terraform run.
+func testProviderServer(t *testing.T) tfprotov6.ProviderServer {
+	t.Helper()
+	ctx := context.Background()
+
+	server := providerserver.NewProtocol6(New("test")())()
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	configType := schemaResp.Provider.ValueType().(tftypes.Object)
+	config := testObjectValue(t, configType, map[string]tftypes.Value{
+		"host": tftypes.NewValue(tftypes.String, testAccAPI.URL),
+	})
+
+	resp, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{
+		Config: testDynamicValue(t, configType, config),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Diagnostics) > 0 {
+		t.Fatalf("unexpected diagnostics configuring the provider: %v", resp.Diagnostics)
+	}
+	return server
+}
+
+// testObjectValue returns an object of the given type with the given
+// attributes, and every other attribute null.
+func testObjectValue(t *testing.T, objectType tftypes.Object, attributes map[string]tftypes.Value) tftypes.Value {
+	t.Helper()
+
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	for name, value := range attributes {
+		if _, ok := values[name]; !ok {
+			t.Fatalf("unknown attribute %q", name)
+		}
+		values[name] = value
+	}
+	return tftypes.NewValue(objectType, values)
+}
+
+func testDynamicValue(t *testing.T, valueType tftypes.Type, value tftypes.Value) *tfprotov6.DynamicValue {
+	t.Helper()
+
+	dynamicValue, err := tfprotov6.NewDynamicValue(valueType, value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &dynamicValue
+}
@@ -2,15 +2,26 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"regexp"
 	// "strconv"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -20,6 +31,10 @@ var (
 	_ resource.Resource		   = &engineerResource{}
 	_ resource.ResourceWithConfigure   = &engineerResource{}
 	_ resource.ResourceWithImportState = &engineerResource{}
+	_ resource.ResourceWithUpgradeState = &engineerResource{}
+	_ resource.ResourceWithModifyPlan = &engineerResource{}
+	_ resource.ResourceWithIdentity = &engineerResource{}
+	_ resource.ResourceWithMoveState = &engineerResource{}
 )
 
 // NewengineerResource is a helper function to simplify the provider implementation.
@@ -36,8 +51,23 @@ type engineerResource struct{
 type engineerResourceModel struct{
 	Id types.String `tfsdk:"id"`
 	Name types.String `tfsdk:"name"`
-	Email types.String `tfsdk:"email"`
-	LastUpdated types.String `tfsdk:"last_updated"`
+	Email EmailValue `tfsdk:"email"`
+	Role types.String `tfsdk:"role"`
+	Seniority types.String `tfsdk:"seniority"`
+	Skills types.Set `tfsdk:"skills"`
+	Location types.String `tfsdk:"location"`
+	Timezone types.String `tfsdk:"timezone"`
+	StartDate types.String `tfsdk:"start_date"`
+	Active types.Bool `tfsdk:"active"`
+	Labels types.Map `tfsdk:"labels"`
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+	OnDestroy types.String `tfsdk:"on_destroy"`
+	AdoptExisting types.Bool `tfsdk:"adopt_existing"`
+	InitialCredential types.String `tfsdk:"initial_credential"`
+	CredentialVersion types.String `tfsdk:"credential_version"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
 }
 
 // Metadata returns the resource type name.
@@ -46,8 +76,11 @@ func (r *engineerResource) Metadata(_ context.Context, req resource.MetadataRequ
 }
 
 // Schema defines the schema for the resource.
-func (r *engineerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *engineerResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		// Version 1 replaced the client-side last_updated timestamp with
+		// the server-provided created_at and updated_at.
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
 				Required: true,
@@ -59,12 +92,208 @@ func (r *engineerResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				},
 			},
 			"email": schema.StringAttribute{
+				Description: "Email address of the engineer. Compared case-insensitively, since the API stores it in lower case.",
+				CustomType: EmailType{},
 				Required: true,
 			},
-			"last_updated": schema.StringAttribute{
+			"role": schema.StringAttribute{
+				Description: "Role of the engineer, e.g. `platform` or `sre`.",
+				Optional: true,
+			},
+			"seniority": schema.StringAttribute{
+				Description: "Seniority level, one of " + engineerSeniorityLevelsMarkdown() + ".",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(engineerSeniorityLevels...),
+				},
+			},
+			"skills": schema.SetAttribute{
+				Description: "Skills of the engineer.",
+				ElementType: types.StringType,
+				Optional: true,
+			},
+			"location": schema.StringAttribute{
+				Description: "Location of the engineer, e.g. `Portland, OR`.",
+				Optional: true,
+			},
+			"timezone": schema.StringAttribute{
+				Description: "IANA time zone of the engineer, e.g. `America/Los_Angeles`.",
+				Optional: true,
+			},
+			"start_date": schema.StringAttribute{
+				Description: "Start date of the engineer in `YYYY-MM-DD` format.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(startDateRegexp, "must be a date in YYYY-MM-DD format"),
+				},
+			},
+			"active": schema.BoolAttribute{
+				Description: "Whether the engineer is active. Defaults to `true`.",
+				Optional: true,
+				Computed: true,
+				Default: booldefault.StaticBool(true),
+			},
+			"labels": schema.MapAttribute{
+				Description: "Free-form labels attached to the engineer.",
+				ElementType: types.StringType,
+				Optional: true,
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Description: "Prevents the engineer from being destroyed. It must be set to `false` and applied " +
+					"before the engineer can be destroyed. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default: booldefault.StaticBool(false),
+			},
+			"on_destroy": schema.StringAttribute{
+				Description: "What happens to the engineer in the API when the resource is destroyed: `delete` removes it, " +
+					"`archive` only marks it inactive. Defaults to `delete`.",
+				Optional: true,
+				Computed: true,
+				Default: stringdefault.StaticString(onDestroyDelete),
+				Validators: []validator.String{
+					stringvalidator.OneOf(onDestroyDelete, onDestroyArchive),
+				},
+			},
+			"adopt_existing": schema.BoolAttribute{
+				Description: "When creating, take over an existing engineer with the same email instead of creating " +
+					"a duplicate. The adopted engineer is updated to match the configuration, `initial_credential` included. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default: booldefault.StaticBool(false),
+			},
+			"initial_credential": schema.StringAttribute{
+				Description: "Initial password or invite secret of the engineer. Write-only: it is sent to the API " +
+					"on create, and on update when `credential_version` changes, but never stored in state.",
+				Optional: true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"credential_version": schema.StringAttribute{
+				Description: "Arbitrary version of `initial_credential`. Change it to send a new credential on update.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("initial_credential")),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Time the engineer was created, as reported by the API, in RFC 3339 format.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Time the engineer was last updated, as reported by the API, in RFC 3339 format.",
 				Computed: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+// ModifyPlan checks at plan time that the planned email is not already used
+// by a different engineer, either in the API or elsewhere in the
+// configuration, instead of failing with a conflict halfway through apply.
+func (r *engineerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when destroying, or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan engineerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Email.IsNull() || plan.Email.IsUnknown() {
+		return
+	}
+
+	var state engineerResourceModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// The claim survives the second plan of a replacement, see planClaim
+	claim, diags := loadPlanClaim(ctx, req.Private, resp.Private, state.Id.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Existing engineers are described by ID, new ones only by name
+	claimant := engineerClaimant{
+		Key:         claim.Key,
+		Description: "engineer " + state.Id.ValueString(),
+	}
+	if state.Id.IsNull() {
+		claimant.Description = "new engineer " + plan.Name.String()
+	}
+	if previous, ok := r.client.engineerPlans.Claim(plan.Email.ValueString(), claimant); !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("email"),
+			"Duplicate Engineer Email",
+			"Email "+plan.Email.ValueString()+" is planned for both "+previous.Description+" and "+claimant.Description+" in this configuration. "+
+				"Every engineer must have a unique email.",
+			)
+		return
+	}
+
+	// Only new engineers and email changes can conflict with the API
+	if !req.State.Raw.IsNull() && strings.EqualFold(state.Email.ValueString(), plan.Email.ValueString()) {
+		return
+	}
+
+	engineers, err := r.client.engineerPlans.Engineers(r.client)
+	if err != nil {
+		// Do not block planning on a lookup failure, apply still reports conflicts.
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("email"),
+			"Unable to Check Engineer Email",
+			"Could not list engineers to check that "+plan.Email.ValueString()+" is unused, unexpected error: "+err.Error(),
+			)
+		return
+	}
+
+	for _, engineer := range engineers {
+		// A replacement may reuse the email of the engineer it replaces
+		if engineer.Id == state.Id.ValueString() || engineer.Id == claim.EngineerId {
+			continue
+		}
+		if strings.EqualFold(engineer.Email, plan.Email.ValueString()) {
+			// The existing engineer is taken over on create, which is the point
+			if req.State.Raw.IsNull() && plan.AdoptExisting.ValueBool() {
+				tflog.Info(ctx, "Planned engineer will adopt an existing engineer", map[string]interface{}{
+					"id":    engineer.Id,
+					"email": engineer.Email,
+				})
+				return
+			}
+
+			summary := "Engineer Email Already In Use"
+			detail := "Email "+plan.Email.ValueString()+" already belongs to engineer "+engineer.Name+" ("+engineer.Id+"). "+
+				"Import that engineer, set adopt_existing = true to take it over, or choose a different email."
+
+			// Without a prior state this may be a tainted engineer planned for
+			// replacement, which frees the email before the create runs.
+			if req.State.Raw.IsNull() {
+				resp.Diagnostics.AddAttributeWarning(path.Root("email"), summary, detail+
+					" Creating the engineer fails unless that engineer is destroyed first, as when it is being replaced.")
+				return
+			}
+
+			resp.Diagnostics.AddAttributeError(path.Root("email"), summary, detail)
+			return
+		}
 	}
 }
 
@@ -91,21 +320,70 @@ func (r *engineerResource) Create(ctx context.Context, req resource.CreateReques
 	// 	})
 	// }
 
-	// Create new engineer
-	engineer, err := r.client.CreateEngineer(plan)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating engineer",
-			"Could not create engineer, unexpected error: "+err.Error(),
-			)
+	// Generate API request body from plan
+	apiEngineer, diags := plan.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Adopting or creating the engineer, including waiting for
+	// asynchronous provisioning, is bounded by the create timeout.
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultEngineerCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// The initial credential is taken from the configuration since
+	// write-only values are never part of the plan.
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("initial_credential"), &plan.InitialCredential)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
+	apiEngineer.InitialCredential = plan.InitialCredential.ValueString()
+	plan.InitialCredential = types.StringNull()
+
+	// Take over an existing engineer instead of creating a duplicate
+	var engineer *Engineer
+	if plan.AdoptExisting.ValueBool() {
+		engineer, diags = r.adoptExisting(ctx, apiEngineer)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Create new engineer
+	if engineer == nil {
+		var err error
+		engineer, err = r.client.CreateEngineer(ctx, apiEngineer)
+		var incomplete *IncompleteOperationError
+		if errors.As(err, &incomplete) {
+			resp.Diagnostics.AddError(
+				"Engineer Creation Incomplete",
+				"The API accepted the engineer, but its creation did not complete: "+err.Error()+". "+
+					"The engineer may still be created, follow the operation at "+incomplete.Link+". "+
+					"Once it exists, set adopt_existing = true or import it, creating it again fails because its email is in use.",
+				)
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating engineer",
+				"Could not create engineer, unexpected error: "+err.Error(),
+				)
+			return
+		}
+	}
 
 	// Map response body to schema and populate Computed attribute values
-	plan.Name = types.StringValue(engineer.Name) //engineer.Name.
-	plan.Id = types.StringValue(engineer.Id) //engineer.Id
-	plan.Email = types.StringValue(engineer.Email) //engineer.Email
-	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	resp.Diagnostics.Append(plan.refresh(ctx, engineer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -113,6 +391,10 @@ func (r *engineerResource) Create(ctx context.Context, req resource.CreateReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(setPrivateRevision(ctx, resp.Private, engineer.Revision)...)
+
+	resp.Diagnostics.Append(r.setIdentity(ctx, resp.Identity, plan.Id.ValueString())...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -125,8 +407,32 @@ func (r *engineerResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	// Fetch engineer by Id
-	engineer, err := r.client.GetEngineer(state.Id.ValueString())
+	// State written before adopt_existing existed has it null
+	if state.AdoptExisting.IsNull() {
+		state.AdoptExisting = types.BoolValue(false)
+	}
+
+	revision, diags := getPrivateRevision(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fetch engineer by Id, unless it did not change since the revision last seen
+	engineer, err := r.client.GetEngineerIfNoneMatch(state.Id.ValueString(), revision)
+	if errors.Is(err, ErrNotModified) {
+		tflog.Debug(ctx, "Engineer not modified, keeping state", map[string]interface{}{
+			"id":       state.Id.ValueString(),
+			"revision": revision,
+		})
+		resp.Diagnostics.Append(r.checkIdentity(ctx, req.Identity, state.Id.ValueString())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		resp.Diagnostics.Append(r.setIdentity(ctx, resp.Identity, state.Id.ValueString())...)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading engineer",
@@ -136,9 +442,11 @@ func (r *engineerResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	// overwrite state with data from API
-	state.Name = types.StringValue(engineer.Name) //engineer.Name
-	state.Id = types.StringValue(engineer.Id) //engineer.Id
-	state.Email = types.StringValue(engineer.Email) //engineer.Email
+	resp.Diagnostics.Append(state.refresh(ctx, &engineer)...)
+	resp.Diagnostics.Append(r.checkIdentity(ctx, req.Identity, engineer.Id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// set state
 	diags = resp.State.Set(ctx, &state)
@@ -146,6 +454,9 @@ func (r *engineerResource) Read(ctx context.Context, req resource.ReadRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(setPrivateRevision(ctx, resp.Private, engineer.Revision)...)
+	resp.Diagnostics.Append(r.setIdentity(ctx, resp.Identity, state.Id.ValueString())...)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -170,8 +481,30 @@ func (r *engineerResource) Update(ctx context.Context, req resource.UpdateReques
 	// }
 
 
+	// Generate API request body from plan
+	apiEngineer, diags := plan.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only send the write-only credential again when its version changed
+	var state engineerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !plan.CredentialVersion.Equal(state.CredentialVersion) {
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("initial_credential"), &plan.InitialCredential)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		apiEngineer.InitialCredential = plan.InitialCredential.ValueString()
+		plan.InitialCredential = types.StringNull()
+	}
+
 	// Update existing order
-	_, err := r.client.UpdateEngineer(plan.Id.ValueString(), plan)
+	updatedEngineer, err := r.client.UpdateEngineer(plan.Id.ValueString(), apiEngineer)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Engineer",
@@ -184,27 +517,35 @@ func (r *engineerResource) Update(ctx context.Context, req resource.UpdateReques
                 "Id": plan.Id.ValueString(),
         })
 
-	// Fetch updated items from Engineer
-	updatedEngineer, err := r.client.GetEngineer(plan.Id.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading Engineer",
-			"Could not read Engineer Id "+plan.Name.ValueString()+": "+err.Error(),
-			)
-		return
+	// A versioned PUT response is the authoritative engineer, servers
+	// without revisions need the updated items fetched from Engineer
+	if updatedEngineer.Revision == "" {
+		fetchedEngineer, err := r.client.GetEngineer(plan.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Engineer",
+				"Could not read Engineer Id "+plan.Name.ValueString()+": "+err.Error(),
+				)
+			return
+		}
+		updatedEngineer = &fetchedEngineer
 	}
 
-	// Update resource state with updated items and timestamp
-	plan.Id = types.StringValue(updatedEngineer.Id)
-	plan.Name = types.StringValue(updatedEngineer.Name)
-	plan.Email = types.StringValue(updatedEngineer.Email)
-	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	// Update resource state with updated items and timestamps
+	resp.Diagnostics.Append(plan.refresh(ctx, updatedEngineer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(setPrivateRevision(ctx, resp.Private, updatedEngineer.Revision)...)
+
+	resp.Diagnostics.Append(r.setIdentity(ctx, resp.Identity, plan.Id.ValueString())...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -217,6 +558,35 @@ func (r *engineerResource) Delete(ctx context.Context, req resource.DeleteReques
                 return
         }
 
+        if state.DeletionProtection.ValueBool() {
+                resp.Diagnostics.AddError(
+                        "Engineer is protected from deletion",
+                        "Engineer "+state.Email.ValueString()+" ("+state.Id.ValueString()+") has deletion_protection enabled. "+
+                                "Set deletion_protection = false and apply before destroying it.",
+                        )
+                return
+        }
+
+        // Archiving keeps the engineer record in the API and only deactivates it
+        if state.OnDestroy.ValueString() == onDestroyArchive {
+                apiEngineer, diags := state.toAPI(ctx)
+                resp.Diagnostics.Append(diags...)
+                if resp.Diagnostics.HasError() {
+                        return
+                }
+                active := false
+                apiEngineer.Active = &active
+
+                _, err := r.client.UpdateEngineer(state.Id.ValueString(), apiEngineer)
+                if err != nil && !errors.Is(err, ErrNotFound) {
+                        resp.Diagnostics.AddError(
+                                "Error archiving engineer",
+                                "Could not archive engineer, unexpected error: "+err.Error(),
+                                )
+                }
+                return
+        }
+
         // Delete existing order
         err := r.client.DeleteEngineer(state.Id.ValueString())
         if err != nil {
@@ -250,7 +620,325 @@ func (r *engineerResource) Configure(_ context.Context, req resource.ConfigureRe
 }
 
 
+// ImportState accepts an engineer ID, one of email:<email> and name:<name>
+// which are resolved through the API, or an identity.
 func (r *engineerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-    // Retrieve import ID and save to id attribute
-    resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+    // Resolve the import identifier, or the identity of an import block,
+    // to an engineer ID and save it to the id attribute
+    var id string
+    var diags diag.Diagnostics
+    if req.ID == "" && req.Identity != nil {
+        id, diags = r.importIdentity(ctx, req.Identity)
+    } else {
+        id, diags = r.resolveImportID(req.ID)
+    }
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+    resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+
+    // Provider-side settings are not stored by the API, start from their
+    // defaults so the first plan after import is empty.
+    resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_protection"), false)...)
+    resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("on_destroy"), onDestroyDelete)...)
+    resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("adopt_existing"), false)...)
+
+    resp.Diagnostics.Append(r.setIdentity(ctx, resp.Identity, id)...)
+}
+
+// defaultEngineerCreateTimeout bounds engineer creation, including waiting
+// for asynchronous provisioning, unless timeouts.create is configured.
+const defaultEngineerCreateTimeout = 20 * time.Minute
+
+// Values of the on_destroy attribute.
+const (
+	onDestroyDelete  = "delete"
+	onDestroyArchive = "archive"
+)
+
+// engineerSeniorityLevels lists the seniority levels accepted by the API.
+var engineerSeniorityLevels = []string{"junior", "mid", "senior", "staff", "principal"}
+
+// startDateRegexp matches a calendar date in YYYY-MM-DD format.
+var startDateRegexp = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+
+// engineerSeniorityLevelsMarkdown renders the seniority levels for attribute descriptions.
+func engineerSeniorityLevelsMarkdown() string {
+	return "`" + strings.Join(engineerSeniorityLevels, "`, `") + "`"
+}
+
+// toAPI converts the model into the DevOps API representation of an engineer.
+func (m *engineerResourceModel) toAPI(ctx context.Context) (Engineer, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	engineer := Engineer{
+		Id:        m.Id.ValueString(),
+		Name:      m.Name.ValueString(),
+		Email:     m.Email.ValueString(),
+		Role:      m.Role.ValueString(),
+		Seniority: m.Seniority.ValueString(),
+		Location:  m.Location.ValueString(),
+		Timezone:  m.Timezone.ValueString(),
+		StartDate: m.StartDate.ValueString(),
+		Active:    m.Active.ValueBoolPointer(),
+	}
+
+	if !m.Skills.IsNull() && !m.Skills.IsUnknown() {
+		diags.Append(m.Skills.ElementsAs(ctx, &engineer.Skills, false)...)
+	}
+	if !m.Labels.IsNull() && !m.Labels.IsUnknown() {
+		diags.Append(m.Labels.ElementsAs(ctx, &engineer.Labels, false)...)
+	}
+
+	return engineer, diags
+}
+
+// refresh overwrites the model with the API representation of an engineer.
+// Optional attributes the API reports as empty stay null when they are null
+// in the model, so omitting them from the configuration does not produce a
+// perpetual diff.
+func (m *engineerResourceModel) refresh(ctx context.Context, engineer *Engineer) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	m.Id = types.StringValue(engineer.Id)
+	m.Name = types.StringValue(engineer.Name)
+	m.Email = NewEmailValue(engineer.Email)
+	m.Role = optionalStringValue(m.Role, engineer.Role)
+	m.Seniority = optionalStringValue(m.Seniority, engineer.Seniority)
+	m.Location = optionalStringValue(m.Location, engineer.Location)
+	m.Timezone = optionalStringValue(m.Timezone, engineer.Timezone)
+	m.StartDate = optionalStringValue(m.StartDate, engineer.StartDate)
+	m.Active = types.BoolValue(engineer.IsActive())
+	m.CreatedAt = timestampValue(engineer.CreatedAt)
+	m.UpdatedAt = timestampValue(engineer.UpdatedAt)
+
+	m.Skills, d = optionalSetValue(ctx, m.Skills, engineer.Skills)
+	diags.Append(d...)
+	m.Labels, d = optionalMapValue(ctx, m.Labels, engineer.Labels)
+	diags.Append(d...)
+
+	return diags
+}
+
+// optionalStringValue returns value as a types.String, keeping prior null
+// when the API returned an empty string.
+func optionalStringValue(prior types.String, value string) types.String {
+	if value == "" && prior.IsNull() {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// optionalSetValue returns values as a set of strings, keeping prior null
+// when the API returned no values.
+func optionalSetValue(ctx context.Context, prior types.Set, values []string) (types.Set, diag.Diagnostics) {
+	if len(values) == 0 && prior.IsNull() {
+		return types.SetNull(types.StringType), nil
+	}
+	if values == nil {
+		values = []string{}
+	}
+	return types.SetValueFrom(ctx, types.StringType, values)
+}
+
+// optionalMapValue returns values as a map of strings, keeping prior null
+// when the API returned no values.
+func optionalMapValue(ctx context.Context, prior types.Map, values map[string]string) (types.Map, diag.Diagnostics) {
+	if len(values) == 0 && prior.IsNull() {
+		return types.MapNull(types.StringType), nil
+	}
+	if values == nil {
+		values = map[string]string{}
+	}
+	return types.MapValueFrom(ctx, types.StringType, values)
+}
+
+// timestampValue normalizes an API timestamp to RFC 3339, timestamps the
+// API did not report are null.
+func timestampValue(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		// Not ours to reject, surface the server value unchanged.
+		return types.StringValue(value)
+	}
+	return types.StringValue(t.Format(time.RFC3339))
+}
+
+// adoptExisting takes over the engineer that already has the planned email,
+// updating it to match the plan, initial credential included. It returns nil
+// when there is no engineer to adopt, and an error when the email is
+// ambiguous.
+func (r *engineerResource) adoptExisting(ctx context.Context, planned Engineer) (*Engineer, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	matches, err := r.client.getEngineersByEmail(ctx, planned.Email)
+	if err != nil {
+		diags.AddError(
+			"Error adopting engineer",
+			"Could not look up existing engineer "+planned.Email+", unexpected error: "+err.Error(),
+		)
+		return nil, diags
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, diags
+	case 1:
+	default:
+		diags.AddError(
+			"Multiple Engineers Found",
+			fmt.Sprintf("%d engineers have email %q, cannot decide which one to adopt. Import one of them by ID instead.", len(matches), planned.Email),
+		)
+		return nil, diags
+	}
+
+	tflog.Info(ctx, "Adopting existing engineer", map[string]interface{}{
+		"id":    matches[0].Id,
+		"email": matches[0].Email,
+	})
+
+	planned.Id = matches[0].Id
+	engineer, err := r.client.updateEngineer(ctx, matches[0].Id, planned)
+	if err != nil {
+		diags.AddError(
+			"Error adopting engineer",
+			"Could not update existing engineer "+matches[0].Id+", unexpected error: "+err.Error(),
+		)
+		return nil, diags
+	}
+
+	return engineer, diags
+}
+
+// Prefixes of the import identifiers that look an engineer up instead of
+// naming its ID directly.
+const (
+	importPrefixEmail = "email:"
+	importPrefixName  = "name:"
+	importPrefixId    = "id:"
+)
+
+// resolveImportID turns an import identifier into the ID of exactly one engineer.
+func (r *engineerResource) resolveImportID(importID string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var (
+		by        string
+		value     string
+		engineers []Engineer
+		err       error
+	)
+	switch {
+	case strings.HasPrefix(importID, importPrefixEmail):
+		by, value = "email", strings.TrimPrefix(importID, importPrefixEmail)
+		engineers, err = r.client.GetEngineersByEmail(value)
+	case strings.HasPrefix(importID, importPrefixName):
+		by, value = "name", strings.TrimPrefix(importID, importPrefixName)
+		engineers, err = r.client.GetEngineersByName(value)
+	default:
+		return strings.TrimPrefix(importID, importPrefixId), diags
+	}
+
+	if value == "" {
+		diags.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an engineer ID, %s<email> or %s<name>. Got: %q", importPrefixEmail, importPrefixName, importID),
+		)
+		return "", diags
+	}
+
+	if err != nil {
+		diags.AddError(
+			"Error importing engineer",
+			fmt.Sprintf("Could not look up engineer by %s %q, unexpected error: %s", by, value, err),
+		)
+		return "", diags
+	}
+
+	switch len(engineers) {
+	case 0:
+		diags.AddError(
+			"Engineer Not Found",
+			fmt.Sprintf("No engineer with %s %q exists.", by, value),
+		)
+		return "", diags
+	case 1:
+		return engineers[0].Id, diags
+	}
+
+	ids := make([]string, len(engineers))
+	for i, engineer := range engineers {
+		ids[i] = engineer.Id
+	}
+	diags.AddError(
+		"Multiple Engineers Found",
+		fmt.Sprintf("%d engineers have %s %q: %s. Import one of them by ID instead.", len(engineers), by, value, strings.Join(ids, ", ")),
+	)
+	return "", diags
+}
+
+// privateRevisionKey is the private state key holding the revision (ETag)
+// of the engineer as last returned by the API.
+const privateRevisionKey = "revision"
+
+// privateStateGetter is implemented by the private state of requests.
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+// privateStateSetter is implemented by the private state of responses.
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// getPrivateRevision returns the engineer revision stored in private state,
+// or an empty string if there is none.
+func getPrivateRevision(ctx context.Context, private privateStateGetter) (string, diag.Diagnostics) {
+	value, diags := private.GetKey(ctx, privateRevisionKey)
+	if diags.HasError() || len(value) == 0 {
+		return "", diags
+	}
+
+	var revision string
+	if err := json.Unmarshal(value, &revision); err != nil {
+		// A revision that cannot be decoded only costs a full read.
+		return "", diags
+	}
+
+	return revision, diags
+}
+
+// setPrivateRevision stores the engineer revision in private state, an
+// empty revision removes it.
+func setPrivateRevision(ctx context.Context, private privateStateSetter, revision string) diag.Diagnostics {
+	if revision == "" {
+		return private.SetKey(ctx, privateRevisionKey, nil)
+	}
+
+	value, err := json.Marshal(revision)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"Error storing engineer revision",
+			"Could not encode engineer revision, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	return private.SetKey(ctx, privateRevisionKey, value)
+}
+
+// nullEngineerTimeouts returns an unset timeouts block, for building state
+// that did not come from a plan.
+func nullEngineerTimeouts() timeouts.Value {
+	return timeouts.Value{
+		Object: types.ObjectNull(map[string]attr.Type{
+			"create": types.StringType,
+		}),
+	}
 }
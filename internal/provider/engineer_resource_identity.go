@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// engineerResourceIdentityModel maps the resource identity schema data.
+type engineerResourceIdentityModel struct {
+	Id   types.String `tfsdk:"id"`
+	Host types.String `tfsdk:"host"`
+}
+
+// IdentitySchema defines the identity of an engineer: its ID, qualified by
+// the API host since IDs are only unique within one DevOps API.
+func (r *engineerResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "ID of the engineer.",
+				RequiredForImport: true,
+			},
+			"host": identityschema.StringAttribute{
+				Description:       "DevOps API host the engineer lives in. Defaults to the provider host on import.",
+				OptionalForImport: true,
+			},
+		},
+	}
+}
+
+// setIdentity stores the identity of the engineer with the given ID.
+// Terraform versions without identity support do not send one, in which
+// case there is nothing to set.
+func (r *engineerResource) setIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, engineerID string) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+
+	return identity.Set(ctx, engineerResourceIdentityModel{
+		Id:   types.StringValue(engineerID),
+		Host: types.StringValue(r.client.HostURL),
+	})
+}
+
+// checkIdentity verifies that a previously stored identity still describes
+// the engineer with the given ID, identities must never change.
+func (r *engineerResource) checkIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, engineerID string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if identity == nil || identity.Raw.IsNull() {
+		return diags
+	}
+
+	var prior engineerResourceIdentityModel
+	diags.Append(identity.Get(ctx, &prior)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if !prior.Id.IsNull() && prior.Id.ValueString() != engineerID {
+		diags.AddError(
+			"Unexpected Identity Change",
+			"The API returned engineer "+engineerID+" for the engineer identified by "+prior.Id.ValueString()+". "+
+				"Please report this issue to the provider developers.",
+		)
+	}
+
+	return diags
+}
+
+// importIdentity returns the engineer ID of an identity given in an import
+// block, rejecting identities that belong to a different API host.
+func (r *engineerResource) importIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var imported engineerResourceIdentityModel
+	diags.Append(identity.Get(ctx, &imported)...)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	host := imported.Host.ValueString()
+	if host != "" && strings.TrimSuffix(host, "/") != strings.TrimSuffix(r.client.HostURL, "/") {
+		diags.AddError(
+			"Unexpected Import Identity",
+			"The identity host "+host+" does not match the provider host "+r.client.HostURL+". "+
+				"Import the engineer with a provider configured for that host.",
+		)
+		return "", diags
+	}
+
+	return imported.Id.ValueString(), diags
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource                   = &engineerResource{}
+	_ list.ListResourceWithConfigure      = &engineerResource{}
+	_ list.ListResourceWithValidateConfig = &engineerResource{}
+)
+
+// NewEngineerListResource is a helper function to simplify the provider implementation.
+func NewEngineerListResource() list.ListResource {
+	return &engineerResource{}
+}
+
+// ListResourceConfigSchema defines the filters of list blocks, the same as
+// the ones of the devops_engineers data source.
+func (r *engineerResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	attributes := map[string]schema.Attribute{}
+	for name, attribute := range engineerFilterAttributes() {
+		attributes[name] = attribute
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Lists the engineers matching every given filter, sorted by email then ID.",
+		Attributes:  attributes,
+	}
+}
+
+// ValidateListResourceConfig rejects name regular expressions that do not compile.
+func (r *engineerResource) ValidateListResourceConfig(ctx context.Context, req list.ValidateConfigRequest, resp *list.ValidateConfigResponse) {
+	resp.Diagnostics.Append(validateEngineerFilter(ctx, req.Config)...)
+}
+
+// List streams the engineers matching the configured filters, with their
+// identity and, when Terraform asks for it, the state they would be
+// imported with so it can generate their configuration.
+func (r *engineerResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config engineerFilterModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	// Filters depending on unknown values match nothing yet
+	if !config.known() {
+		tflog.Debug(ctx, "Engineer filters are unknown, listing no engineers")
+		stream.Results = list.NoListResults
+		return
+	}
+
+	filter, filterDiags := config.filter(ctx)
+	diags.Append(filterDiags...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	engineers, err := r.client.GetEngineersWithFilter(filter)
+	if err != nil {
+		diags.AddError(
+			"Unable to List DevOps Engineers",
+			err.Error(),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for i := range engineers {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = fmt.Sprintf("%s <%s>", engineers[i].Name, engineers[i].Email)
+			result.Diagnostics.Append(r.setIdentity(ctx, result.Identity, engineers[i].Id)...)
+			if req.IncludeResource {
+				result.Diagnostics.Append(r.setListedResource(ctx, &result, &engineers[i])...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// setListedResource stores engineer as the resource of a list result, in
+// the state an import would give it.
+func (r *engineerResource) setListedResource(ctx context.Context, result *list.ListResult, engineer *Engineer) diag.Diagnostics {
+	model, diags := newEngineerResourceModel(ctx, engineer)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(result.Resource.Set(ctx, model)...)
+	return diags
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestEngineerResource_list(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "devops_engineer" "ada" {
+  name   = "ada"
+  email  = "ada@query.example.com"
+  labels = { team = "platform" }
+}
+
+resource "devops_engineer" "grace" {
+  name   = "grace"
+  email  = "grace@query.example.com"
+  labels = { team = "compilers" }
+}
+`,
+			},
+			{
+				Query: true,
+				Config: providerConfig + `
+list "devops_engineer" "platform" {
+  provider = devops
+
+  config {
+    email_domain = "query.example.com"
+    labels       = { team = "platform" }
+  }
+}
+`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("devops_engineer.platform", 1),
+					querycheck.ExpectIdentity("devops_engineer.platform", map[string]knownvalue.Check{
+						"id":   knownvalue.NotNull(),
+						"host": knownvalue.NotNull(),
+					}),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Resources engineers can be moved from, identified by provider address and
+// resource type name.
+const (
+	restAPIProviderAddress = "registry.terraform.io/mastercard/restapi"
+	restAPIObjectTypeName  = "restapi_object"
+
+	legacyEngineerProviderAddress = "registry.terraform.io/liatrio/devops-bootcamp"
+	legacyEngineerTypeName        = "devops_engineer"
+)
+
+// restAPIObjectState maps the parts of a restapi_object state, from the
+// generic Mastercard/restapi provider, that describe an engineer.
+type restAPIObjectState struct {
+	Id          string            `json:"id"`
+	Data        string            `json:"data"`
+	ApiData     map[string]string `json:"api_data"`
+	ApiResponse string            `json:"api_response"`
+}
+
+// legacyEngineerState maps the state of the engineer resource of older
+// forks of this provider.
+type legacyEngineerState struct {
+	Id    string `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// MoveState converts engineers managed by other resource types, so that a
+// moved block turns them into a devops_engineer without destroy and create.
+func (r *engineerResource) MoveState(_ context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{StateMover: r.moveFromRestAPIObject},
+		{StateMover: r.moveFromLegacyEngineer},
+	}
+}
+
+// moveFromRestAPIObject converts a restapi_object pointed at the engineers
+// endpoint. The engineer is taken from the last API response when there is
+// one, from the request data otherwise.
+func (r *engineerResource) moveFromRestAPIObject(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	if !isMoveSource(req, restAPIProviderAddress, restAPIObjectTypeName) || req.SourceRawState == nil {
+		return
+	}
+
+	var source restAPIObjectState
+	if err := json.Unmarshal(req.SourceRawState.JSON, &source); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Move Resource State",
+			"Could not decode restapi_object state, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	var engineer Engineer
+	for _, document := range []string{source.ApiResponse, source.Data} {
+		if document == "" {
+			continue
+		}
+		if err := json.Unmarshal([]byte(document), &engineer); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Move Resource State",
+				"Could not decode restapi_object engineer document, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		break
+	}
+
+	// api_data holds the flattened response of older restapi versions
+	if engineer.Name == "" {
+		engineer.Name = source.ApiData["name"]
+	}
+	if engineer.Email == "" {
+		engineer.Email = source.ApiData["email"]
+	}
+	if engineer.Id == "" {
+		engineer.Id = source.Id
+	}
+
+	r.setMovedState(ctx, req, resp, engineer)
+}
+
+// moveFromLegacyEngineer converts the devops_engineer resource of the older
+// liatrio/devops-bootcamp fork of this provider, which only tracked id, name
+// and email.
+func (r *engineerResource) moveFromLegacyEngineer(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	if !isMoveSource(req, legacyEngineerProviderAddress, legacyEngineerTypeName) || req.SourceRawState == nil {
+		return
+	}
+
+	var source legacyEngineerState
+	if err := json.Unmarshal(req.SourceRawState.JSON, &source); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Move Resource State",
+			"Could not decode "+req.SourceTypeName+" state, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	r.setMovedState(ctx, req, resp, Engineer{
+		Id:    source.Id,
+		Name:  source.Name,
+		Email: source.Email,
+	})
+}
+
+// isMoveSource reports whether a move comes from the resource type typeName
+// of the provider at providerAddress. Provider addresses are compared
+// case-insensitively, like Terraform compares them.
+func isMoveSource(req resource.MoveStateRequest, providerAddress string, typeName string) bool {
+	return req.SourceTypeName == typeName && strings.EqualFold(req.SourceProviderAddress, providerAddress)
+}
+
+// setMovedState stores engineer as the target state of a move, with every
+// provider-side setting at its default. The next refresh fills in whatever
+// the source resource did not track, including the identity: resources are
+// not configured during moves, so the API host is not known yet.
+func (r *engineerResource) setMovedState(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse, engineer Engineer) {
+	if engineer.Id == "" || engineer.Name == "" || engineer.Email == "" {
+		resp.Diagnostics.AddError(
+			"Unable to Move Resource State",
+			"The "+req.SourceTypeName+" state does not contain the id, name and email of an engineer.",
+		)
+		return
+	}
+
+	moved, diags := newEngineerResourceModel(ctx, &engineer)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, moved)...)
+}
+
+// newEngineerResourceModel returns the model of an engineer that is not yet
+// in state, with every provider-side setting at its default.
+func newEngineerResourceModel(ctx context.Context, engineer *Engineer) (engineerResourceModel, diag.Diagnostics) {
+	model := engineerResourceModel{
+		Skills:             types.SetNull(types.StringType),
+		Labels:             types.MapNull(types.StringType),
+		DeletionProtection: types.BoolValue(false),
+		OnDestroy:          types.StringValue(onDestroyDelete),
+		AdoptExisting:      types.BoolValue(false),
+		InitialCredential:  types.StringNull(),
+		CredentialVersion:  types.StringNull(),
+		Timeouts:           nullEngineerTimeouts(),
+	}
+
+	diags := model.refresh(ctx, engineer)
+
+	return model, diags
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestEngineerResource_moveState(t *testing.T) {
+	cases := map[string]struct {
+		providerAddress string
+		typeName        string
+		state           string
+		wantMoved       bool
+	}{
+		"restapi_object": {
+			providerAddress: restAPIProviderAddress,
+			typeName:        restAPIObjectTypeName,
+			state:           `{"id":"7","data":"{\"name\":\"moved\",\"email\":\"moved@liatriolife.com\"}","api_response":"{\"id\":\"7\",\"name\":\"moved\",\"email\":\"moved@liatriolife.com\"}"}`,
+			wantMoved:       true,
+		},
+		"legacy engineer": {
+			providerAddress: legacyEngineerProviderAddress,
+			typeName:        legacyEngineerTypeName,
+			state:           `{"id":"7","name":"moved","email":"moved@liatriolife.com","last_updated":"Tuesday, 04-Jun-24 15:04:05 UTC"}`,
+			wantMoved:       true,
+		},
+		"restapi_object of another provider": {
+			providerAddress: "registry.terraform.io/example/restapi",
+			typeName:        restAPIObjectTypeName,
+			state:           `{"id":"7","data":"{\"name\":\"moved\",\"email\":\"moved@liatriolife.com\"}"}`,
+		},
+		"other engineer type of the legacy provider": {
+			providerAddress: legacyEngineerProviderAddress,
+			typeName:        "devops_lead_engineer",
+			state:           `{"id":"7","name":"moved","email":"moved@liatriolife.com"}`,
+		},
+		"engineer type of another provider": {
+			providerAddress: "registry.terraform.io/example/hr",
+			typeName:        "hr_engineer",
+			state:           `{"id":"7","name":"moved","email":"moved@liatriolife.com"}`,
+		},
+	}
+
+	ctx := context.Background()
+	server := providerserver.NewProtocol6(New("test")())()
+
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	objectType := schemaResp.ResourceSchemas["devops_engineer"].ValueType()
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			resp, err := server.MoveResourceState(ctx, &tfprotov6.MoveResourceStateRequest{
+				SourceProviderAddress: tc.providerAddress,
+				SourceTypeName:        tc.typeName,
+				SourceState:           &tfprotov6.RawState{JSON: []byte(tc.state)},
+				TargetTypeName:        "devops_engineer",
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !tc.wantMoved {
+				if len(resp.Diagnostics) == 0 {
+					t.Fatal("expected the move to be rejected")
+				}
+				return
+			}
+			if len(resp.Diagnostics) > 0 {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			moved, err := resp.TargetState.Unmarshal(objectType)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var attributes map[string]tftypes.Value
+			if err := moved.As(&attributes); err != nil {
+				t.Fatal(err)
+			}
+			for attribute, want := range map[string]string{"id": "7", "name": "moved", "email": "moved@liatriolife.com"} {
+				var got string
+				if err := attributes[attribute].As(&got); err != nil || got != want {
+					t.Errorf("%s: expected %q, got %q (%v)", attribute, want, got, err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,230 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestEngineerResource_readRevision(t *testing.T) {
+	engineer := testCreateEngineer(t, Engineer{Name: "revised", Email: "revised@liatriolife.com"})
+	path := "/engineers/id/" + engineer.Id
+
+	server := testProviderServer(t)
+	objectType, identityType := testEngineerTypes(t, server)
+	state := testObjectValue(t, objectType, map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, engineer.Id),
+	})
+
+	first := testReadEngineer(t, server, objectType, state, nil)
+	second := testReadEngineer(t, server, objectType, testNewState(t, objectType, first.NewState), first.Private)
+
+	requests := testAccAPI.receivedRequests("GET", path)
+	if len(requests) != 2 {
+		t.Fatalf("expected 2 reads of engineer %s, got %d", engineer.Id, len(requests))
+	}
+	if got := requests[0].Header.Get("If-None-Match"); got != "" {
+		t.Errorf("first read sent If-None-Match %s without a stored revision", got)
+	}
+	if got := requests[1].Header.Get("If-None-Match"); got != engineer.Revision {
+		t.Errorf("second read sent If-None-Match %q, want %q", got, engineer.Revision)
+	}
+	if requests[1].Status != 304 {
+		t.Errorf("second read was answered %d, want 304", requests[1].Status)
+	}
+
+	// A 304 keeps the state as it was
+	if got, want := testNewState(t, objectType, second.NewState), testNewState(t, objectType, first.NewState); !got.Equal(want) {
+		t.Errorf("state changed on 304:\ngot:  %s\nwant: %s", got, want)
+	}
+
+	// and still checks the identity, before the framework does
+	identity := tftypes.NewValue(identityType, map[string]tftypes.Value{
+		"id":   tftypes.NewValue(tftypes.String, "another"),
+		"host": tftypes.NewValue(tftypes.String, testAccAPI.URL),
+	})
+	third := testReadEngineerResponse(t, server, objectType, testNewState(t, objectType, first.NewState), first.Private, &tfprotov6.ResourceIdentityData{
+		IdentityData: testDynamicValue(t, identityType, identity),
+	})
+	if len(third.Diagnostics) != 1 || !strings.Contains(third.Diagnostics[0].Detail, "identified by another") {
+		t.Errorf("expected an identity change error, got: %v", third.Diagnostics)
+	}
+}
+
+func TestEngineerResource_readUnversioned(t *testing.T) {
+	engineer := testCreateEngineer(t, Engineer{
+		Name:   "unversioned",
+		Email:  "unversioned@liatriolife.com",
+		Labels: map[string]string{fakeUnversionedLabel: "true"},
+	})
+	path := "/engineers/id/" + engineer.Id
+
+	server := testProviderServer(t)
+	objectType, _ := testEngineerTypes(t, server)
+	state := testObjectValue(t, objectType, map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, engineer.Id),
+	})
+
+	first := testReadEngineer(t, server, objectType, state, nil)
+	testReadEngineer(t, server, objectType, testNewState(t, objectType, first.NewState), first.Private)
+
+	// Without revisions every read is a full read
+	for _, request := range testAccAPI.receivedRequests("GET", path) {
+		if got := request.Header.Get("If-None-Match"); got != "" {
+			t.Errorf("read sent If-None-Match %s without a revision", got)
+		}
+		if request.Status != 200 {
+			t.Errorf("read was answered %d, want 200", request.Status)
+		}
+	}
+}
+
+func TestEngineerResource_updateRevision(t *testing.T) {
+	cases := map[string]struct {
+		labels map[string]string
+		// wantReads is the number of reads following the update.
+		wantReads int
+	}{
+		"versioned": {},
+		"unversioned": {
+			labels:    map[string]string{fakeUnversionedLabel: "true"},
+			wantReads: 1,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			engineer := testCreateEngineer(t, Engineer{
+				Name:   "before " + name,
+				Email:  name + ".update@liatriolife.com",
+				Labels: tc.labels,
+			})
+
+			server := testProviderServer(t)
+			objectType, _ := testEngineerTypes(t, server)
+			read := testReadEngineer(t, server, objectType, testObjectValue(t, objectType, map[string]tftypes.Value{
+				"id": tftypes.NewValue(tftypes.String, engineer.Id),
+			}), nil)
+			prior := testNewState(t, objectType, read.NewState)
+
+			attributes := map[string]tftypes.Value{}
+			if err := prior.As(&attributes); err != nil {
+				t.Fatal(err)
+			}
+			attributes["name"] = tftypes.NewValue(tftypes.String, "after "+name)
+			planned := tftypes.NewValue(objectType, attributes)
+			config := testObjectValue(t, objectType, map[string]tftypes.Value{
+				"name":   attributes["name"],
+				"email":  attributes["email"],
+				"labels": attributes["labels"],
+			})
+
+			resp, err := server.ApplyResourceChange(ctx, &tfprotov6.ApplyResourceChangeRequest{
+				TypeName:       "devops_engineer",
+				PriorState:     testDynamicValue(t, objectType, prior),
+				PlannedState:   testDynamicValue(t, objectType, planned),
+				Config:         testDynamicValue(t, objectType, config),
+				PlannedPrivate: read.Private,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(resp.Diagnostics) > 0 {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			// Reads after the first one follow the update
+			reads := testAccAPI.receivedRequests("GET", "/engineers/id/"+engineer.Id)
+			if got := len(reads) - 1; got != tc.wantReads {
+				t.Errorf("expected %d reads after the update, got %d", tc.wantReads, got)
+			}
+
+			updated := map[string]tftypes.Value{}
+			if err := testNewState(t, objectType, resp.NewState).As(&updated); err != nil {
+				t.Fatal(err)
+			}
+			if !updated["name"].Equal(attributes["name"]) {
+				t.Errorf("expected name %s, got %s", attributes["name"], updated["name"])
+			}
+		})
+	}
+}
+
+// testCreateEngineer creates engineer in testAccAPI for the duration of the
+// test.
+func testCreateEngineer(t *testing.T, engineer Engineer) *Engineer {
+	t.Helper()
+
+	client, err := NewClient(&testAccAPI.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	created, err := client.CreateEngineer(context.Background(), engineer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = client.DeleteEngineer(created.Id)
+	})
+	return created
+}
+
+// testEngineerTypes returns the state and identity types of devops_engineer.
+func testEngineerTypes(t *testing.T, server tfprotov6.ProviderServer) (tftypes.Object, tftypes.Object) {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	identityResp, err := server.GetResourceIdentitySchemas(ctx, &tfprotov6.GetResourceIdentitySchemasRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return schemaResp.ResourceSchemas["devops_engineer"].ValueType().(tftypes.Object),
+		identityResp.IdentitySchemas["devops_engineer"].ValueType().(tftypes.Object)
+}
+
+// testReadEngineer refreshes state, failing on any diagnostic.
+func testReadEngineer(t *testing.T, server tfprotov6.ProviderServer, objectType tftypes.Object, state tftypes.Value, private []byte) *tfprotov6.ReadResourceResponse {
+	t.Helper()
+
+	resp := testReadEngineerResponse(t, server, objectType, state, private, nil)
+	if len(resp.Diagnostics) > 0 {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	return resp
+}
+
+// testReadEngineerResponse refreshes state, leaving diagnostics to the
+// caller.
+func testReadEngineerResponse(t *testing.T, server tfprotov6.ProviderServer, objectType tftypes.Object, state tftypes.Value, private []byte, identity *tfprotov6.ResourceIdentityData) *tfprotov6.ReadResourceResponse {
+	t.Helper()
+
+	resp, err := server.ReadResource(context.Background(), &tfprotov6.ReadResourceRequest{
+		TypeName:        "devops_engineer",
+		CurrentState:    testDynamicValue(t, objectType, state),
+		CurrentIdentity: identity,
+		Private:         private,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp
+}
+
+// testNewState decodes a state returned by the provider.
+func testNewState(t *testing.T, objectType tftypes.Object, state *tfprotov6.DynamicValue) tftypes.Value {
+	t.Helper()
+
+	value, err := state.Unmarshal(objectType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return value
+}
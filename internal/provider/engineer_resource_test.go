@@ -0,0 +1,511 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestEngineerResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "devops_engineer" "test" {
+  name   = "testie_mctestface"
+  email  = "testie@liatriolife.com"
+  skills = ["go", "terraform"]
+  labels = {
+    cohort = "2024-summer"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devops_engineer.test", "name", "testie_mctestface"),
+					resource.TestCheckResourceAttr("devops_engineer.test", "email", "testie@liatriolife.com"),
+					resource.TestCheckResourceAttr("devops_engineer.test", "skills.#", "2"),
+					resource.TestCheckResourceAttr("devops_engineer.test", "labels.cohort", "2024-summer"),
+					resource.TestCheckResourceAttr("devops_engineer.test", "active", "true"),
+					resource.TestCheckResourceAttr("devops_engineer.test", "on_destroy", "delete"),
+					resource.TestCheckResourceAttrSet("devops_engineer.test", "id"),
+					resource.TestCheckResourceAttrSet("devops_engineer.test", "created_at"),
+					resource.TestCheckResourceAttrSet("devops_engineer.test", "updated_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "devops_engineer.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// ImportState by email and by name
+			{
+				ResourceName:      "devops_engineer.test",
+				ImportState:       true,
+				ImportStateId:     "email:Testie@liatriolife.com",
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "devops_engineer.test",
+				ImportState:       true,
+				ImportStateId:     "name:testie_mctestface",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "devops_engineer" "test" {
+  name                = "testie_mctestface_jr"
+  email               = "testie@liatriolife.com"
+  deletion_protection = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devops_engineer.test", "name", "testie_mctestface_jr"),
+					resource.TestCheckNoResourceAttr("devops_engineer.test", "skills"),
+					resource.TestCheckResourceAttr("devops_engineer.test", "deletion_protection", "true"),
+				),
+			},
+			// Deletion protection testing
+			{
+				Config: providerConfig + `
+resource "devops_engineer" "test" {
+  name                = "testie_mctestface_jr"
+  email               = "testie@liatriolife.com"
+  deletion_protection = true
+}
+`,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`Engineer is protected from deletion`),
+			},
+			// Disable protection again so the test can clean up
+			{
+				Config: providerConfig + `
+resource "devops_engineer" "test" {
+  name  = "testie_mctestface_jr"
+  email = "testie@liatriolife.com"
+}
+`,
+				Check: resource.TestCheckResourceAttr("devops_engineer.test", "deletion_protection", "false"),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestEngineerResource_asyncCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Provisioning that fails is reported without saving the engineer
+			{
+				Config: providerConfig + `
+resource "devops_engineer" "test" {
+  name   = "provisioned"
+  email  = "provisioned@liatriolife.com"
+  labels = { ` + fakeCreateLabel + ` = "` + fakeCreateFailed + `" }
+}
+`,
+				ExpectError: regexp.MustCompile(fakeFailingStep + " failed"),
+			},
+			// Provisioning is only waited for up to timeouts.create
+			{
+				Config: providerConfig + `
+resource "devops_engineer" "test" {
+  name   = "provisioned"
+  email  = "provisioned@liatriolife.com"
+  labels = { ` + fakeCreateLabel + ` = "` + fakeCreatePending + `" }
+
+  timeouts {
+    create = "2s"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`(?s)Engineer Creation Incomplete.*timed out waiting for operation`),
+			},
+			// Provisioning that completes gives the created engineer
+			{
+				Config: providerConfig + `
+resource "devops_engineer" "test" {
+  name   = "provisioned"
+  email  = "provisioned@liatriolife.com"
+  labels = { ` + fakeCreateLabel + ` = "` + fakeCreateAsync + `" }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("devops_engineer.test", "id"),
+					resource.TestCheckResourceAttr("devops_engineer.test", "email", "provisioned@liatriolife.com"),
+					resource.TestCheckResourceAttrSet("devops_engineer.test", "created_at"),
+				),
+			},
+		},
+	})
+}
+
+func TestEngineerResource_emailCase(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid emails are rejected at plan time
+			{
+				Config: providerConfig + `
+resource "devops_engineer" "test" {
+  name  = "casey"
+  email = "Casey <casey@liatriolife.com>"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Email Address`),
+			},
+			// A mixed-case email does not diff against the lower-cased API value
+			{
+				Config: providerConfig + `
+resource "devops_engineer" "test" {
+  name  = "casey"
+  email = "Casey@LiatrioLife.com"
+}
+`,
+				Check: resource.TestCheckResourceAttr("devops_engineer.test", "email", "Casey@LiatrioLife.com"),
+			},
+		},
+	})
+}
+
+func TestEngineerResource_duplicateEmail(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "devops_engineer" "first" {
+  name  = "dupe"
+  email = "dupe@liatriolife.com"
+}
+
+resource "devops_engineer" "second" {
+  name  = "dupe_again"
+  email = "Dupe@liatriolife.com"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Duplicate Engineer Email`),
+			},
+			// New engineers are told apart even when they share a name
+			{
+				Config: providerConfig + `
+resource "devops_engineer" "first" {
+  name  = "dupe"
+  email = "dupe@liatriolife.com"
+}
+
+resource "devops_engineer" "second" {
+  name  = "dupe"
+  email = "dupe@liatriolife.com"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Duplicate Engineer Email`),
+			},
+			// Emails managed by devops_engineers are claimed too
+			{
+				Config: providerConfig + `
+resource "devops_engineer" "single" {
+  name  = "dupe"
+  email = "dupe@liatriolife.com"
+}
+
+resource "devops_engineers" "bulk" {
+  engineers = {
+    "Dupe@liatriolife.com" = { name = "dupe" }
+  }
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Duplicate Engineer Email`),
+			},
+		},
+	})
+}
+
+func TestEngineerResource_identity(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "devops_engineer" "test" {
+  name  = "idris"
+  email = "idris@liatriolife.com"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState("devops_engineer.test", tfjsonpath.New("id")),
+				},
+			},
+			// Import with an import block targeting the identity
+			{
+				ResourceName:    "devops_engineer.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestEngineerResource_adoptExisting(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// removed blocks need 1.7, write-only attributes 1.11
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			// Create an engineer the way the old onboarding scripts did
+			{
+				Config: providerConfig + `
+resource "devops_engineer" "legacy" {
+  name  = "ada"
+  email = "ada.adopted@liatriolife.com"
+}
+`,
+			},
+			// Forget it and take it over from a new resource
+			{
+				Config: providerConfig + `
+removed {
+  from = devops_engineer.legacy
+
+  lifecycle {
+    destroy = false
+  }
+}
+
+resource "devops_engineer" "adopted" {
+  name               = "ada lovelace"
+  email              = "ada.adopted@liatriolife.com"
+  adopt_existing     = true
+  initial_credential = "adopted-secret"
+  credential_version = "1"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devops_engineer.adopted", "name", "ada lovelace"),
+					resource.TestCheckResourceAttrSet("devops_engineer.adopted", "created_at"),
+					resource.TestCheckNoResourceAttr("devops_engineer.adopted", "initial_credential"),
+					testAccCheckCredentialsReceived("ada.adopted@liatriolife.com", "adopted-secret"),
+				),
+			},
+		},
+	})
+}
+
+func TestAdoptExisting(t *testing.T) {
+	client, err := NewClient(&testAccAPI.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &engineerResource{client: client}
+
+	existing, err := client.CreateEngineer(context.Background(), Engineer{Name: "grace", Email: "grace.adopted@liatriolife.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = client.DeleteEngineer(existing.Id) })
+
+	planned := Engineer{Name: "grace hopper", Email: "Grace.Adopted@liatriolife.com", InitialCredential: "adopted-secret"}
+
+	// The create timeout bounds the lookup and the update
+	expired, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+	if _, diags := r.adoptExisting(expired, planned); !diags.HasError() {
+		t.Fatal("expected adopting with an expired timeout to fail")
+	} else if detail := diags[0].Detail(); !strings.Contains(detail, context.DeadlineExceeded.Error()) {
+		t.Errorf("expected a deadline error, got %q", detail)
+	}
+
+	adopted, diags := r.adoptExisting(context.Background(), planned)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if adopted == nil || adopted.Id != existing.Id || adopted.Name != "grace hopper" {
+		t.Fatalf("expected engineer %s to be adopted and renamed, got %+v", existing.Id, adopted)
+	}
+	if err := testAccCheckCredentialsReceived(existing.Email, "adopted-secret")(nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestEngineerResource_initialCredential(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "devops_engineer" "test" {
+  name               = "wo"
+  email              = "wo@liatriolife.com"
+  initial_credential = "hunter2"
+  credential_version = "1"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("devops_engineer.test", "initial_credential"),
+					resource.TestCheckResourceAttr("devops_engineer.test", "credential_version", "1"),
+					testAccCheckCredentialsReceived("wo@liatriolife.com", "hunter2"),
+				),
+			},
+			// Rotating the credential only changes the version in state
+			{
+				Config: providerConfig + `
+resource "devops_engineer" "test" {
+  name               = "wo"
+  email              = "wo@liatriolife.com"
+  initial_credential = "correct-horse-battery-staple"
+  credential_version = "2"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("devops_engineer.test", "initial_credential"),
+					resource.TestCheckResourceAttr("devops_engineer.test", "credential_version", "2"),
+					testAccCheckCredentialsReceived("wo@liatriolife.com", "hunter2", "correct-horse-battery-staple"),
+				),
+			},
+		},
+	})
+}
+
+func TestEngineerResource_archiveOnDestroy(t *testing.T) {
+	const email = "archived@liatriolife.com"
+
+	client, err := NewClient(&testAccAPI.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The archived engineer outlives the test, delete it for the next runs.
+	t.Cleanup(func() {
+		if engineer, ok := testAccAPI.engineerByEmail(email); ok {
+			_ = client.DeleteEngineer(engineer.Id)
+		}
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "devops_engineer" "test" {
+  name       = "archie"
+  email      = "` + email + `"
+  on_destroy = "archive"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devops_engineer.test", "on_destroy", "archive"),
+					resource.TestCheckResourceAttr("devops_engineer.test", "active", "true"),
+				),
+			},
+		},
+		// Destroying only marks the engineer inactive in the API
+		CheckDestroy: func(_ *terraform.State) error {
+			engineer, ok := testAccAPI.engineerByEmail(email)
+			if !ok {
+				return fmt.Errorf("engineer %s was deleted instead of archived", email)
+			}
+			if engineer.Active == nil || *engineer.Active {
+				return fmt.Errorf("engineer %s is still active after being archived", email)
+			}
+			return nil
+		},
+	})
+}
+
+func TestEngineerResource_moveFromRestAPIObject(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheckExternalProviders(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			// Create the engineer with the generic REST provider
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"restapi": {
+						Source:            "Mastercard/restapi",
+						VersionConstraint: "~> 1.20",
+					},
+				},
+				Config: fmt.Sprintf(`
+provider "restapi" {
+  uri                  = %q
+  write_returns_object = true
+}
+
+resource "restapi_object" "engineer" {
+  path      = "/engineers"
+  read_path = "/engineers/id/{id}"
+  data      = jsonencode({ name = "moved", email = "moved@liatriolife.com" })
+}
+`, testAccAPI.URL),
+			},
+			// Move it into devops_engineer without replacing it
+			{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Config: providerConfig + `
+moved {
+  from = restapi_object.engineer
+  to   = devops_engineer.engineer
+}
+
+resource "devops_engineer" "engineer" {
+  name  = "moved"
+  email = "moved@liatriolife.com"
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("devops_engineer.engineer", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("devops_engineer.engineer", "name", "moved"),
+			},
+		},
+	})
+}
+
+// testAccCheckCredentialsReceived checks that the fake DevOps API received
+// exactly the given initial credentials for the engineer with email, and
+// did not store any of them with the engineer.
+func testAccCheckCredentialsReceived(email string, want ...string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		engineer, ok := testAccAPI.engineerByEmail(email)
+		if !ok {
+			return fmt.Errorf("no engineer with email %s", email)
+		}
+		if engineer.InitialCredential != "" {
+			return fmt.Errorf("engineer %s stored its initial credential", email)
+		}
+		if got := testAccAPI.receivedCredentials(engineer.Id); !slices.Equal(got, want) {
+			return fmt.Errorf("expected credentials %q to be received for %s, got %q", want, email, got)
+		}
+		return nil
+	}
+}
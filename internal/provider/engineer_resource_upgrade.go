@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// engineerResourceModelV0 maps version 0 of the resource schema data, as
+// released before the profile attributes were added.
+type engineerResourceModelV0 struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Email       types.String `tfsdk:"email"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+}
+
+// UpgradeState migrates state written by earlier versions of the resource schema.
+func (r *engineerResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 0 tracked a client-side last_updated timestamp in RFC 850
+		// format, version 1 has the server-provided created_at and updated_at.
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":           schema.StringAttribute{Computed: true},
+					"name":         schema.StringAttribute{Required: true},
+					"email":        schema.StringAttribute{Required: true},
+					"last_updated": schema.StringAttribute{Computed: true},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior engineerResourceModelV0
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				// Every attribute added since version 0 starts from its
+				// default, the next refresh fills in the profile from the API.
+				upgraded := engineerResourceModel{
+					Id:                 prior.Id,
+					Name:               prior.Name,
+					Email:              EmailValue{StringValue: prior.Email},
+					Role:               types.StringNull(),
+					Seniority:          types.StringNull(),
+					Skills:             types.SetNull(types.StringType),
+					Location:           types.StringNull(),
+					Timezone:           types.StringNull(),
+					StartDate:          types.StringNull(),
+					Active:             types.BoolValue(true),
+					Labels:             types.MapNull(types.StringType),
+					DeletionProtection: types.BoolValue(false),
+					OnDestroy:          types.StringValue(onDestroyDelete),
+					AdoptExisting:      types.BoolValue(false),
+					InitialCredential:  types.StringNull(),
+					CredentialVersion:  types.StringNull(),
+					Timeouts:           nullEngineerTimeouts(),
+					// The creation time was never recorded, the next refresh
+					// fills it in from the API.
+					CreatedAt: types.StringNull(),
+					UpdatedAt: upgradeLastUpdated(prior.LastUpdated),
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, upgraded)...)
+			},
+		},
+	}
+}
+
+// upgradeLastUpdated converts a version 0 last_updated value, written with
+// time.RFC850 on the provider host, to RFC 3339. Values that cannot be
+// parsed are dropped, the next refresh replaces them with the server time.
+func upgradeLastUpdated(lastUpdated types.String) types.String {
+	if lastUpdated.IsNull() || lastUpdated.IsUnknown() {
+		return types.StringNull()
+	}
+
+	t, err := time.Parse(time.RFC850, lastUpdated.ValueString())
+	if err != nil {
+		return types.StringNull()
+	}
+	return types.StringValue(t.Format(time.RFC3339))
+}
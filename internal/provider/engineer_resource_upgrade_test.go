@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestEngineerResource_upgradeFromV0(t *testing.T) {
+	ctx := context.Background()
+	server := providerserver.NewProtocol6(New("test")())()
+
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	objectType := schemaResp.ResourceSchemas["devops_engineer"].ValueType().(tftypes.Object)
+
+	// State as written by the baseline release, with its RFC 850 timestamp
+	upgradeResp, err := server.UpgradeResourceState(ctx, &tfprotov6.UpgradeResourceStateRequest{
+		TypeName: "devops_engineer",
+		Version:  0,
+		RawState: &tfprotov6.RawState{
+			JSON: []byte(`{"id":"42","name":"ada","email":"ada@liatriolife.com","last_updated":"Tuesday, 04-Jun-24 15:04:05 UTC"}`),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(upgradeResp.Diagnostics) > 0 {
+		t.Fatalf("unexpected diagnostics: %v", upgradeResp.Diagnostics)
+	}
+
+	upgraded, err := upgradeResp.UpgradedState.Unmarshal(objectType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var attributes map[string]tftypes.Value
+	if err := upgraded.As(&attributes); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]string{
+		"id":         "42",
+		"name":       "ada",
+		"email":      "ada@liatriolife.com",
+		"on_destroy": onDestroyDelete,
+		"updated_at": "2024-06-04T15:04:05Z",
+	}
+	for name, want := range expected {
+		var got string
+		if err := attributes[name].As(&got); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if got != want {
+			t.Errorf("%s: expected %q, got %q", name, want, got)
+		}
+	}
+	var updatedAt string
+	_ = attributes["updated_at"].As(&updatedAt)
+	if _, err := time.Parse(time.RFC3339, updatedAt); err != nil {
+		t.Errorf("updated_at is not RFC 3339: %v", err)
+	}
+
+	// The configuration the baseline state was written for plans no change
+	config := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		config[name] = tftypes.NewValue(attributeType, nil)
+	}
+	config["name"] = attributes["name"]
+	config["email"] = attributes["email"]
+
+	prior, err := tfprotov6.NewDynamicValue(objectType, upgraded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	configValue, err := tfprotov6.NewDynamicValue(objectType, tftypes.NewValue(objectType, config))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	planResp, err := server.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         "devops_engineer",
+		PriorState:       &prior,
+		ProposedNewState: &prior,
+		Config:           &configValue,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(planResp.Diagnostics) > 0 {
+		t.Fatalf("unexpected diagnostics: %v", planResp.Diagnostics)
+	}
+
+	planned, err := planResp.PlannedState.Unmarshal(objectType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diffs, err := upgraded.Diff(planned); err != nil || len(diffs) > 0 {
+		t.Errorf("expected an empty plan, got differences %v (%v)", diffs, err)
+	}
+	if len(planResp.RequiresReplace) > 0 {
+		t.Errorf("expected no replacement, got %v", planResp.RequiresReplace)
+	}
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestGetEngineersWithFilter(t *testing.T) {
+	filter := EngineerFilter{
+		EmailDomain: "@Liatrio.com",
+		Labels:      map[string]string{"tier": "1", "team": "platform"},
+		TeamType:    teamTypeDev,
+		TeamId:      "7",
+	}
+	match := Engineer{Id: "1", Name: "match", Email: "match@liatrio.com", Labels: map[string]string{"team": "platform", "tier": "1"}}
+	others := []Engineer{
+		{Id: "2", Name: "other domain", Email: "domain@example.com", Labels: map[string]string{"team": "platform", "tier": "1"}},
+		{Id: "3", Name: "other label", Email: "label@liatrio.com", Labels: map[string]string{"team": "platform", "tier": "2"}},
+		{Id: "4", Name: "other team", Email: "team@liatrio.com", Labels: map[string]string{"team": "platform", "tier": "1"}},
+	}
+
+	cases := map[string]struct {
+		// applied is the X-Applied-Filters header of the engineer list.
+		applied   string
+		engineers []Engineer
+		wantTeam  bool
+	}{
+		"filtered by the API": {
+			applied:   "email_domain, labels, team",
+			engineers: []Engineer{match},
+		},
+		"ignored by the API": {
+			engineers: append([]Engineer{match}, others...),
+			wantTeam:  true,
+		},
+		"team ignored by the API": {
+			applied:   "email_domain,labels",
+			engineers: []Engineer{match, others[2]},
+			wantTeam:  true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			teamRequested := false
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /engineers", func(w http.ResponseWriter, r *http.Request) {
+				want := "email_domain=Liatrio.com&labels=team%3Dplatform&labels=tier%3D1&team=dev%2F7"
+				if r.URL.RawQuery != want {
+					t.Errorf("expected query %q, got %q", want, r.URL.RawQuery)
+				}
+				if tc.applied != "" {
+					w.Header().Set(appliedFiltersHeader, tc.applied)
+				}
+				_ = json.NewEncoder(w).Encode(tc.engineers)
+			})
+			mux.HandleFunc("GET /dev/id/7", func(w http.ResponseWriter, _ *http.Request) {
+				teamRequested = true
+				_ = json.NewEncoder(w).Encode(Team{Id: "7", Name: "platform", Engineers: []Engineer{match, others[0], others[1]}})
+			})
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client, err := NewClient(&server.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			engineers, err := client.GetEngineersWithFilter(filter)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(engineers, []Engineer{match}) {
+				t.Errorf("expected only %s, got %+v", match.Email, engineers)
+			}
+			if teamRequested != tc.wantTeam {
+				t.Errorf("expected the team to be requested: %t, got %t", tc.wantTeam, teamRequested)
+			}
+		})
+	}
+}
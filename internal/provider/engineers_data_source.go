@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &engineersDataSource{}
+	_ datasource.DataSourceWithConfigure      = &engineersDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &engineersDataSource{}
+)
+
+// NewEngineersDataSource is a helper function to simplify the provider implementation.
+func NewEngineersDataSource() datasource.DataSource {
+	return &engineersDataSource{}
+}
+
+// engineersDataSource lists the engineers matching a set of filters.
+type engineersDataSource struct {
+	client *Client
+}
+
+// engineersDataSourceModel maps the data source schema data.
+type engineersDataSourceModel struct {
+	engineerFilterModel
+	Engineers []engineerDataSourceModel `tfsdk:"engineers"`
+}
+
+// Metadata returns the data source type name.
+func (d *engineersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_engineers"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *engineersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Schema defines the schema for the data source.
+func (d *engineersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := engineerFilterAttributes()
+	attributes["engineers"] = schema.ListNestedAttribute{
+		Description: "Matching engineers.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: engineerDataSourceAttributes(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Lists the engineers matching every given filter, sorted by email then ID.",
+		Attributes:  attributes,
+	}
+}
+
+// ValidateConfig rejects name regular expressions that do not compile.
+func (d *engineersDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(validateEngineerFilter(ctx, req.Config)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *engineersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state engineersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Filters depending on unknown values are read once they are known
+	if !state.known() {
+		if req.ClientCapabilities.DeferralAllowed {
+			resp.Deferred = &datasource.Deferred{
+				Reason: datasource.DeferredReasonDataSourceConfigUnknown,
+			}
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unknown Engineer Filter",
+			"The engineers can only be read once every filter is known.",
+		)
+		return
+	}
+
+	filter, diags := state.filter(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	engineers, err := d.client.GetEngineersWithFilter(filter)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read DevOps Engineers",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Engineers = make([]engineerDataSourceModel, len(engineers))
+	for i := range engineers {
+		resp.Diagnostics.Append(state.Engineers[i].refresh(ctx, &engineers[i])...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// engineerDataSourceAttributes returns the computed attributes describing a
+// single engineer, as read by the engineer data sources.
+func engineerDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"name": schema.StringAttribute{
+			Computed: true,
+		},
+		"email": schema.StringAttribute{
+			CustomType: EmailType{},
+			Computed:   true,
+		},
+		"role": schema.StringAttribute{
+			Computed: true,
+		},
+		"seniority": schema.StringAttribute{
+			Computed: true,
+		},
+		"skills": schema.SetAttribute{
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"location": schema.StringAttribute{
+			Computed: true,
+		},
+		"timezone": schema.StringAttribute{
+			Computed: true,
+		},
+		"start_date": schema.StringAttribute{
+			Computed: true,
+		},
+		"active": schema.BoolAttribute{
+			Computed: true,
+		},
+		"labels": schema.MapAttribute{
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"created_at": schema.StringAttribute{
+			Computed: true,
+		},
+		"updated_at": schema.StringAttribute{
+			Computed: true,
+		},
+	}
+}
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestEngineersDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Filter by name, domain and labels, results sorted by email
+			{
+				Config: providerConfig + `
+resource "devops_engineer" "ada" {
+  name   = "ada"
+  email  = "ada@filter.example.com"
+  labels = { team = "platform" }
+}
+
+resource "devops_engineer" "alan" {
+  name   = "alan"
+  email  = "alan@filter.example.com"
+  labels = { team = "platform" }
+}
+
+resource "devops_engineer" "grace" {
+  name   = "grace"
+  email  = "grace@filter.example.com"
+  labels = { team = "compilers" }
+}
+
+data "devops_engineers" "test" {
+  name_regex   = "^a"
+  email_domain = "FILTER.example.com"
+  labels       = { team = "platform" }
+
+  depends_on = [
+    devops_engineer.ada,
+    devops_engineer.alan,
+    devops_engineer.grace,
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.devops_engineers.test", "engineers.#", "2"),
+					resource.TestCheckResourceAttr("data.devops_engineers.test", "engineers.0.email", "ada@filter.example.com"),
+					resource.TestCheckResourceAttr("data.devops_engineers.test", "engineers.1.email", "alan@filter.example.com"),
+					resource.TestCheckResourceAttrPair("data.devops_engineers.test", "engineers.0.id", "devops_engineer.ada", "id"),
+				),
+			},
+			// Invalid regular expressions are rejected before reading
+			{
+				Config: providerConfig + `
+data "devops_engineers" "test" {
+  name_regex = "("
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Name Regular Expression`),
+			},
+		},
+	})
+}
+
+// TestEngineersDataSource_unknownFilters reads the data source with filters
+// only known after apply, which Terraform can send when deferrals are
+// allowed.
+func TestEngineersDataSource_unknownFilters(t *testing.T) {
+	ctx := context.Background()
+	server := testProviderServer(t)
+
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	objectType := schemaResp.DataSourceSchemas["devops_engineers"].ValueType().(tftypes.Object)
+	labelsType := objectType.AttributeTypes["labels"]
+	teamType := objectType.AttributeTypes["team"].(tftypes.Object)
+
+	cases := map[string]struct {
+		filters      map[string]tftypes.Value
+		deferred     bool
+		wantDeferred bool
+		wantError    bool
+		wantCount    int
+	}{
+		"unknown labels": {
+			filters: map[string]tftypes.Value{
+				"labels": tftypes.NewValue(labelsType, tftypes.UnknownValue),
+			},
+			deferred:     true,
+			wantDeferred: true,
+		},
+		"unknown team ID": {
+			filters: map[string]tftypes.Value{
+				"team": tftypes.NewValue(teamType, map[string]tftypes.Value{
+					"type": tftypes.NewValue(tftypes.String, teamTypeDev),
+					"id":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				}),
+			},
+			deferred:     true,
+			wantDeferred: true,
+		},
+		"unknown without deferrals": {
+			filters: map[string]tftypes.Value{
+				"labels": tftypes.NewValue(labelsType, tftypes.UnknownValue),
+			},
+			wantError: true,
+		},
+		"known team": {
+			filters: map[string]tftypes.Value{
+				"team": tftypes.NewValue(teamType, map[string]tftypes.Value{
+					"type": tftypes.NewValue(tftypes.String, teamTypeDev),
+					"id":   tftypes.NewValue(tftypes.String, testAccRollupDevTeamId),
+				}),
+			},
+			deferred:  true,
+			wantCount: 2,
+		},
+		"known labels": {
+			filters: map[string]tftypes.Value{
+				"labels": tftypes.NewValue(labelsType, map[string]tftypes.Value{
+					"cohort": tftypes.NewValue(tftypes.String, "nobody"),
+				}),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			resp, err := server.ReadDataSource(ctx, &tfprotov6.ReadDataSourceRequest{
+				TypeName: "devops_engineers",
+				Config:   testDynamicValue(t, objectType, testObjectValue(t, objectType, tc.filters)),
+				ClientCapabilities: &tfprotov6.ReadDataSourceClientCapabilities{
+					DeferralAllowed: tc.deferred,
+				},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tc.wantError {
+				if len(resp.Diagnostics) != 1 || resp.Diagnostics[0].Severity != tfprotov6.DiagnosticSeverityError {
+					t.Fatalf("expected an error, got: %v", resp.Diagnostics)
+				}
+				return
+			}
+			if len(resp.Diagnostics) > 0 {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if got := resp.Deferred != nil; got != tc.wantDeferred {
+				t.Fatalf("expected deferred %t, got %t", tc.wantDeferred, got)
+			}
+			if tc.wantDeferred {
+				return
+			}
+
+			var attributes map[string]tftypes.Value
+			var engineers []tftypes.Value
+			if err := testNewState(t, objectType, resp.State).As(&attributes); err != nil {
+				t.Fatal(err)
+			}
+			if err := attributes["engineers"].As(&engineers); err != nil {
+				t.Fatal(err)
+			}
+			if len(engineers) != tc.wantCount {
+				t.Errorf("expected %d engineers, got %d", tc.wantCount, len(engineers))
+			}
+		})
+	}
+}
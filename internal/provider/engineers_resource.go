@@ -0,0 +1,448 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &engineersResource{}
+	_ resource.ResourceWithConfigure  = &engineersResource{}
+	_ resource.ResourceWithModifyPlan = &engineersResource{}
+)
+
+// NewEngineersResource is a helper function to simplify the provider implementation.
+func NewEngineersResource() resource.Resource {
+	return &engineersResource{}
+}
+
+// engineersResource manages a whole set of engineers, keyed by email, as a
+// single Terraform object.
+type engineersResource struct {
+	client *Client
+}
+
+// engineersResourceModel maps the resource schema data.
+type engineersResourceModel struct {
+	Id        types.String                   `tfsdk:"id"`
+	Engineers map[string]engineersEntryModel `tfsdk:"engineers"`
+}
+
+// engineersEntryModel maps a single engineer of the set, its email is the map key.
+type engineersEntryModel struct {
+	Id   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+// Metadata returns the resource type name.
+func (r *engineersResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_engineers"
+}
+
+// Schema defines the schema for the resource.
+func (r *engineersResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a set of engineers keyed by email in a single resource.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"engineers": schema.MapNestedAttribute{
+				Description: "Engineers to manage, keyed by email address. Keys are compared case-insensitively, like the API compares emails.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID of the engineer. Null while its creation failed, the next apply tries to create it again.",
+							Computed:    true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"name": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan claims the planned emails like devops_engineer does, so that
+// an email managed twice, by this resource and a devops_engineer or by two
+// entries differing only in case, is reported at plan time. It also plans
+// the engineers that failed to be created again.
+func (r *engineersResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when destroying, or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var engineers types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("engineers"), &engineers)...)
+	if resp.Diagnostics.HasError() || engineers.IsNull() || engineers.IsUnknown() {
+		return
+	}
+
+	claim, diags := loadPlanClaim(ctx, req.Private, resp.Private, "")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Each entry claims its email on its own, under the key of this resource
+	for _, email := range sortedKeys(engineers.Elements()) {
+		claimant := engineerClaimant{
+			Key:         claim.Key + "/" + email,
+			Description: "devops_engineers entry " + email,
+		}
+		if previous, ok := r.client.engineerPlans.Claim(email, claimant); !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("engineers").AtMapKey(email),
+				"Duplicate Engineer Email",
+				"Email "+email+" is planned for both "+previous.Description+" and "+claimant.Description+" in this configuration. "+
+					"Every engineer must have a unique email.",
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() || req.State.Raw.IsNull() {
+		return
+	}
+
+	// Engineers whose creation failed have no ID in state, planning one
+	// makes Update create them again.
+	var state engineersResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, email := range sortedKeys(state.Engineers) {
+		if _, ok := engineers.Elements()[email]; ok && state.Engineers[email].Id.IsNull() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("engineers").AtMapKey(email).AtName("id"), types.StringUnknown())...)
+		}
+	}
+}
+
+// Create creates every engineer of the set and sets the initial Terraform state.
+func (r *engineersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan engineersResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating engineers",
+			"Could not generate resource ID, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state := engineersResourceModel{
+		Id:        types.StringValue(id),
+		Engineers: map[string]engineersEntryModel{},
+	}
+	// Failed creations are only warnings, kept in state for Update to
+	// retry. An error would taint the set, and replacing it would delete
+	// and recreate the engineers that were created.
+	resp.Diagnostics.Append(r.reconcile(ctx, &state, plan.Engineers)...)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *engineersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state engineersResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A single list call is far cheaper than one GET per engineer.
+	engineers, err := r.client.getEngineers(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading engineers",
+			"Could not read engineers, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	byId := make(map[string]Engineer, len(engineers))
+	for _, engineer := range engineers {
+		byId[engineer.Id] = engineer
+	}
+
+	refreshed := make(map[string]engineersEntryModel, len(state.Engineers))
+	for email, entry := range state.Engineers {
+		// Engineers not created yet are kept for Update to retry
+		if entry.Id.IsNull() {
+			refreshed[email] = entry
+			continue
+		}
+
+		engineer, ok := byId[entry.Id.ValueString()]
+		if !ok {
+			tflog.Warn(ctx, "Engineer no longer exists, removing it from state", map[string]interface{}{
+				"email": email,
+				"id":    entry.Id.ValueString(),
+			})
+			continue
+		}
+
+		// The API stores emails in lower case, keep the configured key
+		// unless the email really changed outside of Terraform.
+		if !strings.EqualFold(engineer.Email, email) {
+			email = engineer.Email
+		}
+		refreshed[email] = engineersEntryModel{
+			Id:   types.StringValue(engineer.Id),
+			Name: types.StringValue(engineer.Name),
+		}
+	}
+	state.Engineers = refreshed
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update applies the minimal set of creates, updates and deletes needed to
+// turn the prior state into the plan.
+func (r *engineersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state engineersResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.reconcile(ctx, &state, plan.Engineers)...)
+
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes every engineer of the set and removes the Terraform state on success.
+func (r *engineersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state engineersResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Engineers not created yet have nothing to delete
+	var emails, ids []string
+	for _, email := range sortedKeys(state.Engineers) {
+		if id := state.Engineers[email].Id; !id.IsNull() {
+			emails = append(emails, email)
+			ids = append(ids, id.ValueString())
+		}
+	}
+
+	errs := r.client.DeleteEngineers(ctx, ids)
+	for i, err := range errs {
+		if err != nil && !errors.Is(err, ErrNotFound) {
+			resp.Diagnostics.AddError(
+				"Error deleting engineer",
+				"Could not delete engineer "+emails[i]+", unexpected error: "+err.Error(),
+			)
+		}
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *engineersResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// reconcile creates, updates and deletes engineers so that state matches
+// desired. Only successful operations are applied to state, which therefore
+// always reflects what exists in the API even when some calls fail. Failed
+// creations are the exception: they are kept in state without an ID and
+// reported as warnings, so that ModifyPlan plans them again.
+func (r *engineersResource) reconcile(ctx context.Context, state *engineersResourceModel, desired map[string]engineersEntryModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if state.Engineers == nil {
+		state.Engineers = map[string]engineersEntryModel{}
+	}
+
+	// Emails are compared case-insensitively, changing only the case of a
+	// key renames it in state instead of replacing the engineer.
+	for _, email := range sortedKeys(desired) {
+		if _, ok := state.Engineers[email]; ok {
+			continue
+		}
+		for _, current := range sortedKeys(state.Engineers) {
+			if _, ok := desired[current]; !ok && strings.EqualFold(current, email) {
+				state.Engineers[email] = state.Engineers[current]
+				delete(state.Engineers, current)
+				break
+			}
+		}
+	}
+
+	var createEmails, updateEmails, deleteEmails []string
+	for _, email := range sortedKeys(desired) {
+		current, ok := state.Engineers[email]
+		switch {
+		case !ok, current.Id.IsNull():
+			createEmails = append(createEmails, email)
+		case !current.Name.Equal(desired[email].Name):
+			updateEmails = append(updateEmails, email)
+		}
+	}
+	for _, email := range sortedKeys(state.Engineers) {
+		if _, ok := desired[email]; ok {
+			continue
+		}
+		// Engineers not created yet only leave state
+		if state.Engineers[email].Id.IsNull() {
+			delete(state.Engineers, email)
+			continue
+		}
+		deleteEmails = append(deleteEmails, email)
+	}
+
+	tflog.Debug(ctx, "Reconciling engineers", map[string]interface{}{
+		"create": len(createEmails),
+		"update": len(updateEmails),
+		"delete": len(deleteEmails),
+	})
+
+	creates := make([]Engineer, len(createEmails))
+	for i, email := range createEmails {
+		creates[i] = Engineer{
+			Name:  desired[email].Name.ValueString(),
+			Email: email,
+		}
+	}
+	created, errs := r.client.CreateEngineers(ctx, creates)
+	for i, email := range createEmails {
+		if errs[i] != nil {
+			diags.AddAttributeWarning(
+				path.Root("engineers").AtMapKey(email),
+				"Engineer Not Created",
+				"Could not create engineer "+email+", unexpected error: "+errs[i].Error()+". "+
+					"The next apply tries to create it again.",
+			)
+			state.Engineers[email] = engineersEntryModel{
+				Id:   types.StringNull(),
+				Name: desired[email].Name,
+			}
+			continue
+		}
+		state.Engineers[email] = engineersEntryModel{
+			Id:   types.StringValue(created[i].Id),
+			Name: types.StringValue(created[i].Name),
+		}
+	}
+
+	// Updates replace the whole engineer, start from the current one so
+	// profile attributes managed elsewhere are kept.
+	current := map[string]Engineer{}
+	if len(updateEmails) > 0 {
+		engineers, err := r.client.getEngineers(ctx)
+		if err != nil {
+			diags.AddError(
+				"Error updating engineers",
+				"Could not read engineers before updating them, unexpected error: "+err.Error(),
+			)
+			updateEmails = nil
+		}
+		for _, engineer := range engineers {
+			current[engineer.Id] = engineer
+		}
+	}
+
+	updates := make([]Engineer, len(updateEmails))
+	for i, email := range updateEmails {
+		id := state.Engineers[email].Id.ValueString()
+		updates[i] = current[id]
+		updates[i].Id = id
+		updates[i].Name = desired[email].Name.ValueString()
+		updates[i].Email = email
+	}
+	updated, errs := r.client.UpdateEngineers(ctx, updates)
+	for i, email := range updateEmails {
+		if errs[i] != nil {
+			diags.AddError(
+				"Error updating engineer",
+				"Could not update engineer "+email+", unexpected error: "+errs[i].Error(),
+			)
+			continue
+		}
+		state.Engineers[email] = engineersEntryModel{
+			Id:   state.Engineers[email].Id,
+			Name: types.StringValue(updated[i].Name),
+		}
+	}
+
+	deletes := make([]string, len(deleteEmails))
+	for i, email := range deleteEmails {
+		deletes[i] = state.Engineers[email].Id.ValueString()
+	}
+	errs = r.client.DeleteEngineers(ctx, deletes)
+	for i, email := range deleteEmails {
+		if errs[i] != nil && !errors.Is(errs[i], ErrNotFound) {
+			diags.AddError(
+				"Error deleting engineer",
+				"Could not delete engineer "+email+", unexpected error: "+errs[i].Error(),
+			)
+			continue
+		}
+		delete(state.Engineers, email)
+	}
+
+	return diags
+}
+
+// sortedKeys returns the keys of m in ascending order so API calls and
+// diagnostics are deterministic.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
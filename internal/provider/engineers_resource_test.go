@@ -0,0 +1,263 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestEngineersResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "devops_engineers" "test" {
+  engineers = {
+    "ada@liatriolife.com"   = { name = "ada" }
+    "grace@liatriolife.com" = { name = "grace" }
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devops_engineers.test", "engineers.%", "2"),
+					resource.TestCheckResourceAttr("devops_engineers.test", "engineers.ada@liatriolife.com.name", "ada"),
+					resource.TestCheckResourceAttrSet("devops_engineers.test", "engineers.ada@liatriolife.com.id"),
+					resource.TestCheckResourceAttrSet("devops_engineers.test", "engineers.grace@liatriolife.com.id"),
+				),
+			},
+			// Update, add and remove in one step
+			{
+				Config: providerConfig + `
+resource "devops_engineers" "test" {
+  engineers = {
+    "ada@liatriolife.com"   = { name = "ada lovelace" }
+    "linus@liatriolife.com" = { name = "linus" }
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devops_engineers.test", "engineers.%", "2"),
+					resource.TestCheckResourceAttr("devops_engineers.test", "engineers.ada@liatriolife.com.name", "ada lovelace"),
+					resource.TestCheckNoResourceAttr("devops_engineers.test", "engineers.grace@liatriolife.com.id"),
+					resource.TestCheckResourceAttrSet("devops_engineers.test", "engineers.linus@liatriolife.com.id"),
+				),
+			},
+		},
+	})
+}
+
+func TestEngineersResource_mixedCaseKeys(t *testing.T) {
+	var adaId string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Mixed-case keys converge although the API lower-cases emails
+			{
+				Config: providerConfig + `
+resource "devops_engineers" "test" {
+  engineers = {
+    "Ada@Bulk.example.com"   = { name = "ada" }
+    "grace@bulk.example.com" = { name = "grace" }
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devops_engineers.test", "engineers.%", "2"),
+					resource.TestCheckResourceAttrWith("devops_engineers.test", "engineers.Ada@Bulk.example.com.id", func(id string) error {
+						adaId = id
+						return nil
+					}),
+				),
+			},
+			// Changing the case of a key and the name keeps the engineer,
+			// and its profile managed outside of this resource
+			{
+				PreConfig: func() {
+					err := testAccAPI.editEngineer("ada@bulk.example.com", func(engineer *Engineer) {
+						engineer.Skills = []string{"go"}
+						engineer.Labels = map[string]string{"cohort": "2024-summer"}
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: providerConfig + `
+resource "devops_engineers" "test" {
+  engineers = {
+    "ada@bulk.example.com"   = { name = "ada lovelace" }
+    "grace@bulk.example.com" = { name = "grace" }
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devops_engineers.test", "engineers.%", "2"),
+					resource.TestCheckResourceAttr("devops_engineers.test", "engineers.ada@bulk.example.com.name", "ada lovelace"),
+					resource.TestCheckResourceAttrPtr("devops_engineers.test", "engineers.ada@bulk.example.com.id", &adaId),
+					func(_ *terraform.State) error {
+						engineer, ok := testAccAPI.engineerByEmail("ada@bulk.example.com")
+						if !ok {
+							return fmt.Errorf("engineer ada@bulk.example.com not found in the API")
+						}
+						if !slices.Equal(engineer.Skills, []string{"go"}) || engineer.Labels["cohort"] != "2024-summer" {
+							return fmt.Errorf("profile of engineer ada@bulk.example.com was not kept: %+v", engineer)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestEngineersResource_duplicateEmail(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Keys differing only in case are the same engineer
+			{
+				Config: providerConfig + `
+resource "devops_engineers" "test" {
+  engineers = {
+    "ada@dupe.example.com" = { name = "ada" }
+    "Ada@dupe.example.com" = { name = "ada again" }
+  }
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Duplicate Engineer Email`),
+			},
+		},
+	})
+}
+
+// TestEngineersResource_partialCreate creates a set in which one engineer
+// cannot be created. The set must not fail, which would taint it, and the
+// next apply creates the missing engineer without touching the other one.
+func TestEngineersResource_partialCreate(t *testing.T) {
+	const kept, blocked = "kept@partial.example.com", "blocked@partial.example.com"
+	ctx := context.Background()
+
+	client, err := NewClient(&testAccAPI.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	blocker := testCreateEngineer(t, Engineer{Name: "blocker", Email: blocked})
+	t.Cleanup(func() {
+		for _, email := range []string{kept, blocked} {
+			if engineer, ok := testAccAPI.engineerByEmail(email); ok {
+				_ = client.DeleteEngineer(engineer.Id)
+			}
+		}
+	})
+
+	schemaResp, err := testProviderServer(t).GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	objectType := schemaResp.ResourceSchemas["devops_engineers"].ValueType().(tftypes.Object)
+	mapType := objectType.AttributeTypes["engineers"].(tftypes.Map)
+	entryType := mapType.ElementType.(tftypes.Object)
+
+	entry := func(name string) tftypes.Value {
+		return tftypes.NewValue(entryType, map[string]tftypes.Value{
+			"id":   tftypes.NewValue(tftypes.String, nil),
+			"name": tftypes.NewValue(tftypes.String, name),
+		})
+	}
+	config := testObjectValue(t, objectType, map[string]tftypes.Value{
+		"engineers": tftypes.NewValue(mapType, map[string]tftypes.Value{
+			kept:    entry("kept"),
+			blocked: entry("blocked"),
+		}),
+	})
+
+	// apply plans and applies config in a new run, like terraform apply
+	var private []byte
+	apply := func(prior tftypes.Value) (tftypes.Value, tftypes.Value, []*tfprotov6.Diagnostic) {
+		t.Helper()
+		server := testProviderServer(t)
+		plan, err := server.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+			TypeName:         "devops_engineers",
+			PriorState:       testDynamicValue(t, objectType, prior),
+			ProposedNewState: testDynamicValue(t, objectType, config),
+			Config:           testDynamicValue(t, objectType, config),
+			PriorPrivate:     private,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(plan.Diagnostics) > 0 {
+			t.Fatalf("unexpected diagnostics planning: %v", plan.Diagnostics)
+		}
+		resp, err := server.ApplyResourceChange(ctx, &tfprotov6.ApplyResourceChangeRequest{
+			TypeName:       "devops_engineers",
+			PriorState:     testDynamicValue(t, objectType, prior),
+			PlannedState:   plan.PlannedState,
+			Config:         testDynamicValue(t, objectType, config),
+			PlannedPrivate: plan.PlannedPrivate,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		private = resp.Private
+		return testNewState(t, objectType, plan.PlannedState), testNewState(t, objectType, resp.NewState), resp.Diagnostics
+	}
+
+	_, created, diags := apply(tftypes.NewValue(objectType, nil))
+	if len(diags) != 1 || diags[0].Severity != tfprotov6.DiagnosticSeverityWarning {
+		t.Fatalf("expected a single warning for %s, got: %v", blocked, diags)
+	}
+	keptId := testEngineersEntryId(t, created, kept)
+	if !keptId.IsKnown() || keptId.IsNull() {
+		t.Fatalf("expected %s to be created, got ID %s", kept, keptId)
+	}
+	if id := testEngineersEntryId(t, created, blocked); !id.IsNull() {
+		t.Fatalf("expected %s to be kept without an ID, got %s", blocked, id)
+	}
+
+	if err := client.DeleteEngineer(blocker.Id); err != nil {
+		t.Fatal(err)
+	}
+
+	planned, updated, diags := apply(created)
+	if len(diags) > 0 {
+		t.Fatalf("unexpected diagnostics retrying: %v", diags)
+	}
+	if id := testEngineersEntryId(t, planned, blocked); id.IsKnown() {
+		t.Errorf("expected the ID of %s to be planned unknown, got %s", blocked, id)
+	}
+	if id := testEngineersEntryId(t, updated, kept); !id.Equal(keptId) {
+		t.Errorf("expected %s to keep ID %s, got %s", kept, keptId, id)
+	}
+	if id := testEngineersEntryId(t, updated, blocked); !id.IsKnown() || id.IsNull() {
+		t.Errorf("expected %s to be created, got ID %s", blocked, id)
+	}
+}
+
+// testEngineersEntryId returns the ID of the devops_engineers entry keyed
+// by email.
+func testEngineersEntryId(t *testing.T, state tftypes.Value, email string) tftypes.Value {
+	t.Helper()
+
+	var attributes, engineers, entry map[string]tftypes.Value
+	if err := state.As(&attributes); err != nil {
+		t.Fatal(err)
+	}
+	if err := attributes["engineers"].As(&engineers); err != nil {
+		t.Fatal(err)
+	}
+	if err := engineers[email].As(&entry); err != nil {
+		t.Fatal(err)
+	}
+	return entry["id"]
+}
@@ -0,0 +1,914 @@
+package provider
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Seeded teams and DevOps unit of the fake DevOps API, so acceptance tests
+// can read teams without a way to create them.
+const (
+	testAccDevTeamId    = "1"
+	testAccDevTeamName  = "bootcamp-dev"
+	testAccOpsTeamId    = "1"
+	testAccOpsTeamName  = "bootcamp-ops"
+	testAccDevOpsUnitId = "1"
+)
+
+// Seeded DevOps unit of the fake DevOps API whose dev and ops teams share an
+// engineer, so acceptance tests can check the rollups. Each team also has
+// an engineer of its own.
+const (
+	testAccRollupDevOpsUnitId  = "2"
+	testAccRollupDevTeamId     = "2"
+	testAccRollupDevTeamName   = "rollup-dev"
+	testAccRollupOpsTeamId     = "2"
+	testAccRollupOpsTeamName   = "rollup-ops"
+	testAccSharedEngineerEmail = "shared@rollup.example.com"
+)
+
+// fakeOnboardingSteps are the onboarding steps of the fake DevOps API, run
+// in this order when a request does not pick any.
+var fakeOnboardingSteps = []string{"accounts", "welcome_email"}
+
+// Operation steps of the fake DevOps API with a scripted outcome, so tests
+// can make an operation fail or never finish.
+const (
+	fakeFailingStep = "background_check"
+	fakeStuckStep   = "manager_approval"
+)
+
+// fakeCreateLabel is the engineer label picking how the fake DevOps API
+// creates the engineer. Without it engineers are created synchronously.
+const fakeCreateLabel = "fake_create"
+
+// Values of fakeCreateLabel. Every mode answers 202 Accepted with an
+// operation, that ends as its name says. An orphaned operation is ready
+// without naming the engineer it created.
+const (
+	fakeCreateAsync    = "async"
+	fakeCreateFailed   = "failed"
+	fakeCreatePending  = "pending"
+	fakeCreateOrphaned = "orphaned"
+)
+
+// fakeUnversionedLabel is the engineer label making the fake DevOps API
+// answer without ETags for the engineer, like servers that predate
+// revisions.
+const fakeUnversionedLabel = "fake_unversioned"
+
+// fakeDevOpsAPI is an in-memory DevOps API served over HTTP, implementing
+// every endpoint the client calls so acceptance tests run without a real
+// server. It answers like the real API: 404 for unknown objects, ETags on
+// engineers and 202 with an operation for onboarding and, depending on
+// fakeCreateLabel, engineer creation.
+type fakeDevOpsAPI struct {
+	*httptest.Server
+
+	mu         sync.Mutex
+	lastId     int
+	engineers  map[string]*fakeEngineer
+	teams      map[string]map[string]*fakeTeam
+	devops     map[string]*fakeDevOps
+	operations map[string]*fakeOperation
+	tokens     map[string]*APIToken
+
+	// credentials are the initial credentials received for each engineer
+	// ID, oldest first. They are never stored with the engineer.
+	credentials map[string][]string
+
+	// requests are every request received, oldest first.
+	requests []fakeRequest
+}
+
+// fakeRequest is a request received by the fake DevOps API and the status
+// it was answered with.
+type fakeRequest struct {
+	Method string
+	Path   string
+	Header http.Header
+	Status int
+}
+
+// fakeEngineer is a stored engineer and its revision, bumped on every write.
+type fakeEngineer struct {
+	Engineer
+	revision int
+}
+
+// fakeTeam is a stored team, its members are referenced by ID.
+type fakeTeam struct {
+	Id          string
+	Name        string
+	EngineerIds []string
+}
+
+// fakeDevOps is a stored DevOps unit, its teams are referenced by ID.
+type fakeDevOps struct {
+	Id     string
+	DevIds []string
+	OpsIds []string
+}
+
+// fakeOperation is a stored operation and the steps it has left to run,
+// one of which completes every time the operation is polled. Once none is
+// left, finish completes the operation.
+type fakeOperation struct {
+	Operation
+	Kind    string
+	Steps   []string
+	pending []string
+	finish  func(*fakeOperation)
+}
+
+// Kinds of operations of the fake DevOps API.
+const (
+	fakeOperationCreate     = "create"
+	fakeOperationOnboarding = "onboarding"
+)
+
+// newFakeDevOpsAPI starts a fake DevOps API with the seeded teams.
+func newFakeDevOpsAPI() *fakeDevOpsAPI {
+	api := &fakeDevOpsAPI{
+		engineers: map[string]*fakeEngineer{},
+		teams: map[string]map[string]*fakeTeam{
+			teamTypeDev: {testAccDevTeamId: {Id: testAccDevTeamId, Name: testAccDevTeamName}},
+			teamTypeOps: {testAccOpsTeamId: {Id: testAccOpsTeamId, Name: testAccOpsTeamName}},
+		},
+		devops: map[string]*fakeDevOps{
+			testAccDevOpsUnitId: {
+				Id:     testAccDevOpsUnitId,
+				DevIds: []string{testAccDevTeamId},
+				OpsIds: []string{testAccOpsTeamId},
+			},
+		},
+		operations:  map[string]*fakeOperation{},
+		tokens:      map[string]*APIToken{},
+		credentials: map[string][]string{},
+	}
+
+	shared := api.seedEngineer("shared", testAccSharedEngineerEmail)
+	devOnly := api.seedEngineer("dev only", "dev.only@rollup.example.com")
+	opsOnly := api.seedEngineer("ops only", "ops.only@rollup.example.com")
+	api.teams[teamTypeDev][testAccRollupDevTeamId] = &fakeTeam{
+		Id:          testAccRollupDevTeamId,
+		Name:        testAccRollupDevTeamName,
+		EngineerIds: []string{shared, devOnly},
+	}
+	api.teams[teamTypeOps][testAccRollupOpsTeamId] = &fakeTeam{
+		Id:          testAccRollupOpsTeamId,
+		Name:        testAccRollupOpsTeamName,
+		EngineerIds: []string{shared, opsOnly},
+	}
+	api.devops[testAccRollupDevOpsUnitId] = &fakeDevOps{
+		Id:     testAccRollupDevOpsUnitId,
+		DevIds: []string{testAccRollupDevTeamId},
+		OpsIds: []string{testAccRollupOpsTeamId},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /engineers", api.listEngineers)
+	mux.HandleFunc("POST /engineers", api.createEngineer)
+	mux.HandleFunc("GET /engineers/id/{id}", api.getEngineer)
+	mux.HandleFunc("GET /engineers/name/{name}", api.getEngineersByName)
+	mux.HandleFunc("PUT /engineers/{id}", api.updateEngineer)
+	mux.HandleFunc("DELETE /engineers/{id}", api.deleteEngineer)
+	mux.HandleFunc("POST /engineers/{id}/onboarding", api.startOnboarding)
+	mux.HandleFunc("GET /operations/{id}", api.getOperation)
+	for _, teamType := range teamTypes {
+		mux.HandleFunc("GET /"+teamType+"/id/{id}", api.getTeam(teamType))
+		mux.HandleFunc("GET /"+teamType+"/name/{name}", api.getTeamsByName(teamType))
+		mux.HandleFunc("PUT /"+teamType+"/{id}/engineers/{engineer}", api.addTeamMember(teamType))
+		mux.HandleFunc("DELETE /"+teamType+"/{id}/engineers/{engineer}", api.removeTeamMember(teamType))
+	}
+	mux.HandleFunc("GET /devops/id/{id}", api.getDevOps)
+	mux.HandleFunc("GET /info", api.getInfo)
+	mux.HandleFunc("GET /whoami", api.getWhoAmI)
+	mux.HandleFunc("POST /tokens", api.createToken)
+	mux.HandleFunc("POST /tokens/{id}/renew", api.renewToken)
+	mux.HandleFunc("DELETE /tokens/{id}", api.revokeToken)
+
+	api.Server = httptest.NewServer(api.record(mux))
+
+	return api
+}
+
+// seedEngineer stores an active engineer while the fake is being set up and
+// returns its ID.
+func (api *fakeDevOpsAPI) seedEngineer(name string, email string) string {
+	now := time.Now().UTC().Format(time.RFC3339)
+	active := true
+	engineer := Engineer{
+		Id:        api.nextId(),
+		Name:      name,
+		Email:     email,
+		Active:    &active,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	api.engineers[engineer.Id] = &fakeEngineer{Engineer: engineer, revision: 1}
+
+	return engineer.Id
+}
+
+// record serves requests with next, recording each of them.
+func (api *fakeDevOpsAPI) record(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		recorder := &fakeStatusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r)
+
+		api.mu.Lock()
+		defer api.mu.Unlock()
+		api.requests = append(api.requests, fakeRequest{
+			Method: r.Method,
+			Path:   r.URL.Path,
+			Header: r.Header.Clone(),
+			Status: recorder.status,
+		})
+	})
+}
+
+// fakeStatusRecorder remembers the status written to a response.
+type fakeStatusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *fakeStatusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// receivedRequests returns the requests received for method and path,
+// oldest first.
+func (api *fakeDevOpsAPI) receivedRequests(method string, path string) []fakeRequest {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	var requests []fakeRequest
+	for _, request := range api.requests {
+		if request.Method == method && request.Path == path {
+			requests = append(requests, request)
+		}
+	}
+	return requests
+}
+
+// receiveCredential records the initial credential received for the
+// engineer with engineerId, if any. The caller must hold mu.
+func (api *fakeDevOpsAPI) receiveCredential(engineerId string, credential string) {
+	if credential != "" {
+		api.credentials[engineerId] = append(api.credentials[engineerId], credential)
+	}
+}
+
+// nextId returns a new object ID. The caller must hold mu.
+func (api *fakeDevOpsAPI) nextId() string {
+	api.lastId++
+	return strconv.Itoa(api.lastId)
+}
+
+func (api *fakeDevOpsAPI) listEngineers(w http.ResponseWriter, r *http.Request) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	query := r.URL.Query()
+	var applied []string
+	for _, filter := range []string{engineerFilterEmailDomain, engineerFilterLabels, engineerFilterTeam} {
+		if query.Has(filter) {
+			applied = append(applied, filter)
+		}
+	}
+
+	labels := map[string]string{}
+	for _, label := range query[engineerFilterLabels] {
+		key, value, ok := strings.Cut(label, "=")
+		if !ok {
+			http.Error(w, "labels must be given as key=value", http.StatusBadRequest)
+			return
+		}
+		labels[key] = value
+	}
+
+	var members []string
+	if query.Has(engineerFilterTeam) {
+		teamType, teamId, _ := strings.Cut(query.Get(engineerFilterTeam), "/")
+		team, ok := api.teams[teamType][teamId]
+		if !ok {
+			http.Error(w, "team not found", http.StatusNotFound)
+			return
+		}
+		members = team.EngineerIds
+	}
+
+	engineers := []Engineer{}
+	for _, engineer := range api.sortedEngineers() {
+		_, domain, _ := strings.Cut(engineer.Email, "@")
+		if query.Has(engineerFilterEmailDomain) && !strings.EqualFold(domain, query.Get(engineerFilterEmailDomain)) {
+			continue
+		}
+		if !fakeLabelsMatch(engineer.Labels, labels) {
+			continue
+		}
+		if query.Has(engineerFilterTeam) && !slices.Contains(members, engineer.Id) {
+			continue
+		}
+		engineers = append(engineers, engineer.Engineer)
+	}
+	w.Header().Set(appliedFiltersHeader, strings.Join(applied, ", "))
+	writeFakeJSON(w, http.StatusOK, engineers)
+}
+
+// fakeLabelsMatch reports whether labels has every selector with its value.
+func fakeLabelsMatch(labels map[string]string, selectors map[string]string) bool {
+	for key, value := range selectors {
+		if got, ok := labels[key]; !ok || got != value {
+			return false
+		}
+	}
+	return true
+}
+
+func (api *fakeDevOpsAPI) createEngineer(w http.ResponseWriter, r *http.Request) {
+	var engineer Engineer
+	if !readFakeJSON(w, r, &engineer) {
+		return
+	}
+
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	if msg := api.checkEngineer(engineer, ""); msg != "" {
+		http.Error(w, msg, http.StatusConflict)
+		return
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	engineer.Id = api.nextId()
+	engineer.Email = strings.ToLower(engineer.Email)
+	api.receiveCredential(engineer.Id, engineer.InitialCredential)
+	engineer.InitialCredential = ""
+	engineer.CreatedAt = now
+	engineer.UpdatedAt = now
+	if engineer.Active == nil {
+		active := true
+		engineer.Active = &active
+	}
+
+	stored := &fakeEngineer{Engineer: engineer, revision: 1}
+
+	// The engineer only exists once its provisioning succeeded.
+	var steps []string
+	switch mode := engineer.Labels[fakeCreateLabel]; mode {
+	case "":
+		api.engineers[engineer.Id] = stored
+		stored.setETag(w)
+		writeFakeJSON(w, http.StatusCreated, stored.Engineer)
+		return
+	case fakeCreateAsync, fakeCreateOrphaned:
+		steps = []string{"provisioning"}
+	case fakeCreateFailed:
+		steps = []string{fakeFailingStep}
+	case fakeCreatePending:
+		steps = []string{fakeStuckStep}
+	default:
+		http.Error(w, fmt.Sprintf("unknown %s mode %q", fakeCreateLabel, mode), http.StatusBadRequest)
+		return
+	}
+
+	operation := api.newOperation(fakeOperationCreate, steps, func(operation *fakeOperation) {
+		api.engineers[stored.Id] = stored
+		operation.Status = operationStatusReady
+		if engineer.Labels[fakeCreateLabel] != fakeCreateOrphaned {
+			operation.EngineerId = stored.Id
+		}
+	})
+	operation.Messages = []string{"Provisioning engineer " + stored.Email}
+	writeFakeOperation(w, operation)
+}
+
+func (api *fakeDevOpsAPI) getEngineer(w http.ResponseWriter, r *http.Request) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	engineer, ok := api.engineers[r.PathValue("id")]
+	if !ok {
+		http.Error(w, "engineer not found", http.StatusNotFound)
+		return
+	}
+
+	engineer.setETag(w)
+	if etag := w.Header().Get("ETag"); etag != "" && r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	writeFakeJSON(w, http.StatusOK, engineer.Engineer)
+}
+
+func (api *fakeDevOpsAPI) getEngineersByName(w http.ResponseWriter, r *http.Request) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	engineers := []Engineer{}
+	for _, engineer := range api.sortedEngineers() {
+		if engineer.Name == r.PathValue("name") {
+			engineers = append(engineers, engineer.Engineer)
+		}
+	}
+	if len(engineers) == 0 {
+		http.Error(w, "engineer not found", http.StatusNotFound)
+		return
+	}
+	writeFakeJSON(w, http.StatusOK, engineers)
+}
+
+func (api *fakeDevOpsAPI) updateEngineer(w http.ResponseWriter, r *http.Request) {
+	var engineer Engineer
+	if !readFakeJSON(w, r, &engineer) {
+		return
+	}
+
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	stored, ok := api.engineers[r.PathValue("id")]
+	if !ok {
+		http.Error(w, "engineer not found", http.StatusNotFound)
+		return
+	}
+	if msg := api.checkEngineer(engineer, stored.Id); msg != "" {
+		http.Error(w, msg, http.StatusConflict)
+		return
+	}
+
+	engineer.Id = stored.Id
+	engineer.Email = strings.ToLower(engineer.Email)
+	api.receiveCredential(engineer.Id, engineer.InitialCredential)
+	engineer.InitialCredential = ""
+	engineer.CreatedAt = stored.CreatedAt
+	engineer.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+	if engineer.Active == nil {
+		engineer.Active = stored.Active
+	}
+
+	stored.Engineer = engineer
+	stored.revision++
+	stored.setETag(w)
+	writeFakeJSON(w, http.StatusOK, stored.Engineer)
+}
+
+func (api *fakeDevOpsAPI) deleteEngineer(w http.ResponseWriter, r *http.Request) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	id := r.PathValue("id")
+	if _, ok := api.engineers[id]; !ok {
+		http.Error(w, "engineer not found", http.StatusNotFound)
+		return
+	}
+
+	delete(api.engineers, id)
+	for _, teams := range api.teams {
+		for _, team := range teams {
+			team.EngineerIds = slices.DeleteFunc(team.EngineerIds, func(engineerId string) bool {
+				return engineerId == id
+			})
+		}
+	}
+	writeFakeJSON(w, http.StatusOK, map[string]string{"success": "engineer resource deleted"})
+}
+
+func (api *fakeDevOpsAPI) startOnboarding(w http.ResponseWriter, r *http.Request) {
+	var body onboardingRequest
+	if !readFakeJSON(w, r, &body) {
+		return
+	}
+	for _, step := range body.Steps {
+		if !slices.Contains(fakeOnboardingSteps, step) && step != fakeFailingStep && step != fakeStuckStep {
+			http.Error(w, fmt.Sprintf("unknown onboarding step %q", step), http.StatusBadRequest)
+			return
+		}
+	}
+
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	engineerId := r.PathValue("id")
+	if _, ok := api.engineers[engineerId]; !ok {
+		http.Error(w, "engineer not found", http.StatusNotFound)
+		return
+	}
+
+	steps := body.Steps
+	if len(steps) == 0 {
+		steps = fakeOnboardingSteps
+	}
+
+	operation := api.newOperation(fakeOperationOnboarding, steps, func(operation *fakeOperation) {
+		operation.Status = operationStatusReady
+		operation.Messages = append(operation.Messages, "Onboarding complete")
+	})
+	operation.EngineerId = engineerId
+	writeFakeOperation(w, operation)
+}
+
+// newOperation stores a pending operation of kind running steps, then
+// finish. The caller must hold mu.
+func (api *fakeDevOpsAPI) newOperation(kind string, steps []string, finish func(*fakeOperation)) *fakeOperation {
+	id := api.nextId()
+	operation := &fakeOperation{
+		Operation: Operation{
+			Id:       id,
+			Status:   operationStatusPending,
+			Link:     "/operations/" + id,
+			Messages: []string{},
+		},
+		Kind:    kind,
+		Steps:   slices.Clone(steps),
+		pending: slices.Clone(steps),
+		finish:  finish,
+	}
+	api.operations[id] = operation
+
+	return operation
+}
+
+// writeFakeOperation answers 202 Accepted with operation.
+func writeFakeOperation(w http.ResponseWriter, operation *fakeOperation) {
+	w.Header().Set("Location", operation.Link)
+	writeFakeJSON(w, http.StatusAccepted, operation.Operation)
+}
+
+func (api *fakeDevOpsAPI) getOperation(w http.ResponseWriter, r *http.Request) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	operation, ok := api.operations[r.PathValue("id")]
+	if !ok {
+		http.Error(w, "operation not found", http.StatusNotFound)
+		return
+	}
+
+	switch {
+	case operation.Status == operationStatusReady || operation.Status == operationStatusFailed:
+	case len(operation.pending) == 0:
+		operation.finish(operation)
+	case operation.pending[0] == fakeFailingStep:
+		operation.Status = operationStatusFailed
+		operation.Error = operation.pending[0] + " failed"
+		operation.Messages = append(operation.Messages, "Failed "+operation.pending[0])
+	case operation.pending[0] == fakeStuckStep:
+		operation.Status = operationStatusRunning
+	default:
+		operation.Status = operationStatusRunning
+		operation.Messages = append(operation.Messages, "Completed "+operation.pending[0])
+		operation.pending = operation.pending[1:]
+	}
+	writeFakeJSON(w, http.StatusOK, operation.Operation)
+}
+
+func (api *fakeDevOpsAPI) getTeam(teamType string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		api.mu.Lock()
+		defer api.mu.Unlock()
+
+		team, ok := api.teams[teamType][r.PathValue("id")]
+		if !ok {
+			http.Error(w, "team not found", http.StatusNotFound)
+			return
+		}
+		writeFakeJSON(w, http.StatusOK, api.team(team))
+	}
+}
+
+func (api *fakeDevOpsAPI) getTeamsByName(teamType string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		api.mu.Lock()
+		defer api.mu.Unlock()
+
+		teams := []Team{}
+		for _, team := range api.teams[teamType] {
+			if team.Name == r.PathValue("name") {
+				teams = append(teams, api.team(team))
+			}
+		}
+		if len(teams) == 0 {
+			http.Error(w, "team not found", http.StatusNotFound)
+			return
+		}
+		slices.SortFunc(teams, func(a, b Team) int { return strings.Compare(a.Id, b.Id) })
+		writeFakeJSON(w, http.StatusOK, teams)
+	}
+}
+
+func (api *fakeDevOpsAPI) addTeamMember(teamType string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		api.mu.Lock()
+		defer api.mu.Unlock()
+
+		team, ok := api.teams[teamType][r.PathValue("id")]
+		if !ok {
+			http.Error(w, "team not found", http.StatusNotFound)
+			return
+		}
+		engineerId := r.PathValue("engineer")
+		if _, ok := api.engineers[engineerId]; !ok {
+			http.Error(w, "engineer not found", http.StatusNotFound)
+			return
+		}
+
+		if !slices.Contains(team.EngineerIds, engineerId) {
+			team.EngineerIds = append(team.EngineerIds, engineerId)
+		}
+		writeFakeJSON(w, http.StatusOK, api.team(team))
+	}
+}
+
+func (api *fakeDevOpsAPI) removeTeamMember(teamType string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		api.mu.Lock()
+		defer api.mu.Unlock()
+
+		team, ok := api.teams[teamType][r.PathValue("id")]
+		if !ok {
+			http.Error(w, "team not found", http.StatusNotFound)
+			return
+		}
+		i := slices.Index(team.EngineerIds, r.PathValue("engineer"))
+		if i < 0 {
+			http.Error(w, "engineer is not a member of the team", http.StatusNotFound)
+			return
+		}
+
+		team.EngineerIds = slices.Delete(team.EngineerIds, i, i+1)
+		writeFakeJSON(w, http.StatusOK, api.team(team))
+	}
+}
+
+func (api *fakeDevOpsAPI) getDevOps(w http.ResponseWriter, r *http.Request) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	unit, ok := api.devops[r.PathValue("id")]
+	if !ok {
+		http.Error(w, "devops unit not found", http.StatusNotFound)
+		return
+	}
+
+	devops := DevOps{Id: unit.Id, Devs: []Team{}, Ops: []Team{}}
+	for _, id := range unit.DevIds {
+		devops.Devs = append(devops.Devs, api.team(api.teams[teamTypeDev][id]))
+	}
+	for _, id := range unit.OpsIds {
+		devops.Ops = append(devops.Ops, api.team(api.teams[teamTypeOps][id]))
+	}
+	writeFakeJSON(w, http.StatusOK, devops)
+}
+
+func (api *fakeDevOpsAPI) getInfo(w http.ResponseWriter, _ *http.Request) {
+	writeFakeJSON(w, http.StatusOK, APIInfo{
+		Version: "v1.0.0-fake",
+		Features: map[string]bool{
+			"etags":      true,
+			"onboarding": true,
+			"tokens":     true,
+		},
+	})
+}
+
+func (api *fakeDevOpsAPI) getWhoAmI(w http.ResponseWriter, _ *http.Request) {
+	writeFakeJSON(w, http.StatusOK, WhoAmI{
+		Principal:   "terraform-acceptance-tests",
+		Permissions: []string{"read", "write"},
+	})
+}
+
+func (api *fakeDevOpsAPI) createToken(w http.ResponseWriter, r *http.Request) {
+	var body apiTokenRequest
+	if !readFakeJSON(w, r, &body) {
+		return
+	}
+	if len(body.Scopes) == 0 || body.TTLSeconds <= 0 {
+		http.Error(w, "scopes and a positive ttl_seconds are required", http.StatusBadRequest)
+		return
+	}
+
+	secret := make([]byte, 16)
+	if _, err := rand.Read(secret); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	token := &APIToken{
+		Id:        api.nextId(),
+		Token:     hex.EncodeToString(secret),
+		Scopes:    body.Scopes,
+		ExpiresAt: fakeExpiry(body.TTLSeconds),
+	}
+	api.tokens[token.Id] = token
+	writeFakeJSON(w, http.StatusCreated, token)
+}
+
+func (api *fakeDevOpsAPI) renewToken(w http.ResponseWriter, r *http.Request) {
+	var body apiTokenRequest
+	if !readFakeJSON(w, r, &body) {
+		return
+	}
+	if body.TTLSeconds <= 0 {
+		http.Error(w, "a positive ttl_seconds is required", http.StatusBadRequest)
+		return
+	}
+
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	token, ok := api.tokens[r.PathValue("id")]
+	if !ok {
+		http.Error(w, "token not found", http.StatusNotFound)
+		return
+	}
+
+	token.ExpiresAt = fakeExpiry(body.TTLSeconds)
+	renewed := *token
+	renewed.Token = ""
+	writeFakeJSON(w, http.StatusOK, renewed)
+}
+
+func (api *fakeDevOpsAPI) revokeToken(w http.ResponseWriter, r *http.Request) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	id := r.PathValue("id")
+	if _, ok := api.tokens[id]; !ok {
+		http.Error(w, "token not found", http.StatusNotFound)
+		return
+	}
+
+	delete(api.tokens, id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// onboardings returns a copy of the onboarding operations started for the
+// engineer with engineerId, oldest first.
+func (api *fakeDevOpsAPI) onboardings(engineerId string) []fakeOperation {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	var operations []fakeOperation
+	for _, operation := range api.operations {
+		if operation.Kind == fakeOperationOnboarding && operation.EngineerId == engineerId {
+			operations = append(operations, *operation)
+		}
+	}
+	slices.SortFunc(operations, func(a, b fakeOperation) int {
+		left, _ := strconv.Atoi(a.Id)
+		right, _ := strconv.Atoi(b.Id)
+		return left - right
+	})
+	return operations
+}
+
+// engineerByEmail returns the stored engineer with email, compared
+// case-insensitively.
+func (api *fakeDevOpsAPI) engineerByEmail(email string) (Engineer, bool) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	for _, engineer := range api.engineers {
+		if strings.EqualFold(engineer.Email, email) {
+			return engineer.Engineer, true
+		}
+	}
+	return Engineer{}, false
+}
+
+// receivedCredentials returns the initial credentials received for the
+// engineer with engineerId, oldest first.
+func (api *fakeDevOpsAPI) receivedCredentials(engineerId string) []string {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	return slices.Clone(api.credentials[engineerId])
+}
+
+// hasToken reports whether the token with id is valid, neither revoked nor
+// unknown.
+func (api *fakeDevOpsAPI) hasToken(id string) bool {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	_, ok := api.tokens[id]
+	return ok
+}
+
+// isTeamMember reports whether the engineer with engineerId is a member of
+// the teamType team with teamId.
+func (api *fakeDevOpsAPI) isTeamMember(teamType string, teamId string, engineerId string) bool {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	team, ok := api.teams[teamType][teamId]
+	return ok && slices.Contains(team.EngineerIds, engineerId)
+}
+
+// editEngineer changes the stored engineer with email like a change made
+// outside of Terraform would, bumping its revision.
+func (api *fakeDevOpsAPI) editEngineer(email string, edit func(*Engineer)) error {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	for _, engineer := range api.engineers {
+		if strings.EqualFold(engineer.Email, email) {
+			edit(&engineer.Engineer)
+			engineer.revision++
+			return nil
+		}
+	}
+	return fmt.Errorf("no engineer with email %s", email)
+}
+
+// checkEngineer returns why engineer cannot be stored under id, empty when
+// it can. The caller must hold mu.
+func (api *fakeDevOpsAPI) checkEngineer(engineer Engineer, id string) string {
+	if engineer.Name == "" || engineer.Email == "" {
+		return "name and email are required"
+	}
+	for _, other := range api.engineers {
+		if other.Id != id && strings.EqualFold(other.Email, engineer.Email) {
+			return "an engineer with email " + other.Email + " already exists"
+		}
+	}
+	return ""
+}
+
+// sortedEngineers returns the stored engineers in creation order. The
+// caller must hold mu.
+func (api *fakeDevOpsAPI) sortedEngineers() []*fakeEngineer {
+	engineers := make([]*fakeEngineer, 0, len(api.engineers))
+	for _, engineer := range api.engineers {
+		engineers = append(engineers, engineer)
+	}
+	slices.SortFunc(engineers, func(a, b *fakeEngineer) int {
+		left, _ := strconv.Atoi(a.Id)
+		right, _ := strconv.Atoi(b.Id)
+		return left - right
+	})
+	return engineers
+}
+
+// team returns the API representation of team, with its engineers in the
+// order they joined. The caller must hold mu.
+func (api *fakeDevOpsAPI) team(team *fakeTeam) Team {
+	engineers := []Engineer{}
+	for _, id := range team.EngineerIds {
+		engineers = append(engineers, api.engineers[id].Engineer)
+	}
+	return Team{Id: team.Id, Name: team.Name, Engineers: engineers}
+}
+
+// etag returns the ETag of the current revision of the engineer.
+func (e *fakeEngineer) etag() string {
+	return fmt.Sprintf(`"%s-%d"`, e.Id, e.revision)
+}
+
+// setETag answers with the ETag of the engineer, unless it is labelled
+// with fakeUnversionedLabel.
+func (e *fakeEngineer) setETag(w http.ResponseWriter) {
+	if _, ok := e.Labels[fakeUnversionedLabel]; !ok {
+		w.Header().Set("ETag", e.etag())
+	}
+}
+
+// fakeExpiry returns the RFC 3339 time ttlSeconds from now.
+func fakeExpiry(ttlSeconds int64) string {
+	return time.Now().UTC().Add(time.Duration(ttlSeconds) * time.Second).Format(time.RFC3339)
+}
+
+// readFakeJSON decodes the request body into v, answering 400 Bad Request
+// when it cannot.
+func readFakeJSON(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		http.Error(w, "invalid request body: "+err.Error(), http.StatusBadRequest)
+		return false
+	}
+	return true
+}
+
+// writeFakeJSON answers with status and v encoded as JSON.
+func writeFakeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &emailFromNameFunction{}
+
+// NewEmailFromNameFunction is a helper function to simplify the provider implementation.
+func NewEmailFromNameFunction() function.Function {
+	return &emailFromNameFunction{}
+}
+
+// emailFromNameFunction builds the email of an engineer from their name.
+type emailFromNameFunction struct{}
+
+// Metadata returns the function name.
+func (f *emailFromNameFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "email_from_name"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *emailFromNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build an engineer email from a name and a domain",
+		Description: "Returns the normalized email address made of the engineer handle, as returned by `engineer_handle`, " +
+			"and the given domain. For example `José O'Brien-Smith` and `Liatrio.com` give `jose.obrien.smith@liatrio.com`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "name",
+				Description: "Name of the engineer.",
+			},
+			function.StringParameter{
+				Name:        "domain",
+				Description: "Email domain, with or without a leading `@`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run builds the email from the name and domain arguments.
+func (f *emailFromNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name, domain string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &name, &domain))
+	if resp.Error != nil {
+		return
+	}
+
+	email, funcErr := emailFromName(name, domain)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, email))
+}
+
+// emailFromName returns the normalized email of the engineer name at domain.
+// Errors point at the name or domain argument of email_from_name.
+func emailFromName(name string, domain string) (string, *function.FuncError) {
+	handle := engineerHandle(name)
+	if handle == "" {
+		return "", function.NewArgumentFuncError(0, "The name "+name+" has no letters or digits to derive a handle from.")
+	}
+
+	domain = strings.TrimPrefix(strings.TrimSpace(domain), "@")
+	email, err := normalizeEmail(handle + "@" + domain)
+	if err != nil {
+		return "", function.NewArgumentFuncError(1, "Invalid email domain "+domain+": "+err.Error())
+	}
+
+	return email, nil
+}
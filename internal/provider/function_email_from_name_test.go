@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// TestEmailFromNameFunction is a smoke test of the provider functions through
+// Terraform, their logic is covered by the unit tests below and in the
+// engineer_handle and normalize_email tests.
+func TestEmailFromNameFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "handle" {
+  value = provider::devops::engineer_handle("José O'Brien-Smith")
+}
+
+output "email" {
+  value = provider::devops::email_from_name("José O'Brien-Smith", "@LiatrioLife.com")
+}
+
+output "normalized" {
+  value = provider::devops::normalize_email(" Jose.OBrien.Smith@LiatrioLife.com ")
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("handle", "jose.obrien.smith"),
+					resource.TestCheckOutput("email", "jose.obrien.smith@liatriolife.com"),
+					resource.TestCheckOutput("normalized", "jose.obrien.smith@liatriolife.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestEmailFromName(t *testing.T) {
+	cases := map[string]struct {
+		name        string
+		domain      string
+		want        string
+		wantErrArg  int64
+		wantErrText string
+	}{
+		"plain name":             {name: "Grace Hopper", domain: "liatriolife.com", want: "grace.hopper@liatriolife.com"},
+		"accents and apostrophe": {name: "José O'Brien-Smith", domain: "liatrio.com", want: "jose.obrien.smith@liatrio.com"},
+		"digits":                 {name: "R2-D2", domain: "liatrio.com", want: "r2.d2@liatrio.com"},
+		"at sign and case":       {name: "Grace Hopper", domain: "@LiatrioLife.com", want: "grace.hopper@liatriolife.com"},
+		"surrounding space":      {name: "  Grace Hopper\t", domain: "  @liatriolife.com ", want: "grace.hopper@liatriolife.com"},
+		"nothing left of name":   {name: "李 -- '", domain: "liatrio.com", wantErrArg: 0, wantErrText: "no letters or digits"},
+		"empty name":             {name: "", domain: "liatrio.com", wantErrArg: 0, wantErrText: "no letters or digits"},
+		"domain with spaces":     {name: "Grace Hopper", domain: "not a domain", wantErrArg: 1, wantErrText: "Invalid email domain"},
+		"domain with at sign":    {name: "Grace Hopper", domain: "grace@liatrio.com", wantErrArg: 1, wantErrText: "Invalid email domain"},
+		"empty domain":           {name: "Grace Hopper", domain: "", wantErrArg: 1, wantErrText: "Invalid email domain"},
+		"only an at sign":        {name: "Grace Hopper", domain: "@", wantErrArg: 1, wantErrText: "Invalid email domain"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := emailFromName(tc.name, tc.domain)
+			if tc.wantErrText != "" {
+				if err == nil {
+					t.Fatalf("expected an error, got %q", got)
+				}
+				if err.FunctionArgument == nil || *err.FunctionArgument != tc.wantErrArg {
+					t.Errorf("expected an error on argument %d, got %v", tc.wantErrArg, err.FunctionArgument)
+				}
+				if !strings.Contains(err.Text, tc.wantErrText) {
+					t.Errorf("expected an error containing %q, got %q", tc.wantErrText, err.Text)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Text)
+			}
+			if got != tc.want {
+				t.Errorf("expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
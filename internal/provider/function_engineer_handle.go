@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &engineerHandleFunction{}
+
+// NewEngineerHandleFunction is a helper function to simplify the provider implementation.
+func NewEngineerHandleFunction() function.Function {
+	return &engineerHandleFunction{}
+}
+
+// engineerHandleFunction derives the handle of an engineer from their name.
+type engineerHandleFunction struct{}
+
+// Metadata returns the function name.
+func (f *engineerHandleFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "engineer_handle"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *engineerHandleFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Derive an engineer handle from a name",
+		Description: "Returns the handle the DevOps API derives from an engineer name: accents are removed, apostrophes dropped, " +
+			"the name is lower-cased and every run of other characters than letters and digits becomes a single dot. " +
+			"For example `José O'Brien-Smith` becomes `jose.obrien.smith`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "name",
+				Description: "Name of the engineer.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run derives the handle of the name argument.
+func (f *engineerHandleFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	handle := engineerHandle(name)
+	if handle == "" {
+		resp.Error = function.NewArgumentFuncError(0, "The name "+name+" has no letters or digits to derive a handle from.")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, handle))
+}
+
+// engineerHandle returns the handle of an engineer name, or an empty string
+// when the name has no ASCII letters or digits left once accents are removed.
+func engineerHandle(name string) string {
+	var handle strings.Builder
+
+	separate := false
+	for _, r := range norm.NFD.String(name) {
+		switch {
+		case unicode.Is(unicode.Mn, r), r == '\'', r == '’':
+			// Accents and apostrophes are dropped without separating,
+			// so O'Brien is obrien rather than o.brien.
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			if separate && handle.Len() > 0 {
+				handle.WriteByte('.')
+			}
+			separate = false
+			handle.WriteRune(unicode.ToLower(r))
+		default:
+			separate = true
+		}
+	}
+
+	return handle.String()
+}
@@ -0,0 +1,33 @@
+package provider
+
+import "testing"
+
+func TestEngineerHandle(t *testing.T) {
+	cases := map[string]struct {
+		name string
+		want string
+	}{
+		"plain name":          {name: "Grace Hopper", want: "grace.hopper"},
+		"accents":             {name: "José Zoë Müller", want: "jose.zoe.muller"},
+		"apostrophe":          {name: "Conan O'Brien", want: "conan.obrien"},
+		"typographic quote":   {name: "Conan O’Brien", want: "conan.obrien"},
+		"hyphen":              {name: "Anne-Marie Smith", want: "anne.marie.smith"},
+		"digits":              {name: "R2-D2 3000", want: "r2.d2.3000"},
+		"punctuation runs":    {name: "J. R. R. Tolkien", want: "j.r.r.tolkien"},
+		"surrounding space":   {name: "  \tAda Lovelace \n", want: "ada.lovelace"},
+		"surrounding symbols": {name: "--Ada Lovelace!!", want: "ada.lovelace"},
+		"only symbols":        {name: "---", want: ""},
+		"only apostrophes":    {name: "''", want: ""},
+		"only non-latin":      {name: "李小龍", want: ""},
+		"only whitespace":     {name: " \t ", want: ""},
+		"empty":               {name: "", want: ""},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := engineerHandle(tc.name); got != tc.want {
+				t.Errorf("engineerHandle(%q): expected %q, got %q", tc.name, tc.want, got)
+			}
+		})
+	}
+}
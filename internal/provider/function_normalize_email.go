@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &normalizeEmailFunction{}
+
+// NewNormalizeEmailFunction is a helper function to simplify the provider implementation.
+func NewNormalizeEmailFunction() function.Function {
+	return &normalizeEmailFunction{}
+}
+
+// normalizeEmailFunction canonicalizes an email address like the API does.
+type normalizeEmailFunction struct{}
+
+// Metadata returns the function name.
+func (f *normalizeEmailFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_email"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *normalizeEmailFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Normalize an email address",
+		Description: "Returns the email address the way the DevOps API stores it: trimmed and in lower case. Fails unless the input is a bare email address.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "email",
+				Description: "Email address to normalize.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run normalizes the email argument.
+func (f *normalizeEmailFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var email string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &email))
+	if resp.Error != nil {
+		return
+	}
+
+	normalized, err := normalizeEmail(email)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Invalid email address "+email+": "+err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, normalized))
+}
@@ -0,0 +1,40 @@
+package provider
+
+import "testing"
+
+func TestNormalizeEmail(t *testing.T) {
+	cases := map[string]struct {
+		email   string
+		want    string
+		wantErr bool
+	}{
+		"lower case":        {email: "ada.lovelace@liatriolife.com", want: "ada.lovelace@liatriolife.com"},
+		"mixed case":        {email: "Ada.Lovelace@LiatrioLife.com", want: "ada.lovelace@liatriolife.com"},
+		"surrounding space": {email: "  Ada@LiatrioLife.com \n", want: "ada@liatriolife.com"},
+		"plus tag":          {email: "ada+bootcamp@liatriolife.com", want: "ada+bootcamp@liatriolife.com"},
+		"display name":      {email: "Ada <ada@liatriolife.com>", wantErr: true},
+		"no domain":         {email: "ada@", wantErr: true},
+		"no at sign":        {email: "ada.liatriolife.com", wantErr: true},
+		"inner space":       {email: "ada lovelace@liatriolife.com", wantErr: true},
+		"two addresses":     {email: "ada@liatriolife.com, grace@liatriolife.com", wantErr: true},
+		"empty":             {email: "", wantErr: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := normalizeEmail(tc.email)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("normalizeEmail(%q): expected an error, got %q", tc.email, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("normalizeEmail(%q): unexpected error: %s", tc.email, err)
+			}
+			if got != tc.want {
+				t.Errorf("normalizeEmail(%q): expected %q, got %q", tc.email, tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,376 @@
+package provider
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Formats understood by the render_roster function.
+const (
+	rosterFormatCodeowners = "codeowners"
+	rosterFormatCSV        = "csv"
+	rosterFormatMarkdown   = "markdown"
+)
+
+// rosterFormats lists every valid roster format.
+var rosterFormats = []string{rosterFormatCodeowners, rosterFormatCSV, rosterFormatMarkdown}
+
+// rosterDefaultPattern is the CODEOWNERS pattern of teams without one.
+const rosterDefaultPattern = "*"
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &renderRosterFunction{}
+
+// NewRenderRosterFunction is a helper function to simplify the provider implementation.
+func NewRenderRosterFunction() function.Function {
+	return &renderRosterFunction{}
+}
+
+// renderRosterFunction renders teams and engineers as a text file.
+type renderRosterFunction struct{}
+
+// rosterTeam is a team read from the roster argument. Engineers given
+// outside of a team are gathered in a team without name.
+type rosterTeam struct {
+	Name      string
+	Pattern   string
+	Engineers []rosterEngineer
+}
+
+// rosterEngineer is an engineer read from the roster argument.
+type rosterEngineer struct {
+	Id    string
+	Name  string
+	Email string
+}
+
+// Metadata returns the function name.
+func (f *renderRosterFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "render_roster"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *renderRosterFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Render teams and engineers as CODEOWNERS, CSV or Markdown",
+		Description: "Renders a roster, in the given order, as a CODEOWNERS block, a CSV export or a Markdown table. " +
+			"The roster is a list of team objects, with `name`, `engineers` and an optional CODEOWNERS `pattern` " +
+			"defaulting to `*`, or of engineer objects with `id`, `name` and `email`, such as the outputs of the " +
+			"`devops_dev`, `devops_ops` and `devops_engineers` data sources. Other attributes are ignored. " +
+			"CODEOWNERS rules are only rendered for teams with at least one email.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "format",
+				Description: "Output format, one of `codeowners`, `csv` or `markdown`.",
+			},
+			function.DynamicParameter{
+				Name:        "roster",
+				Description: "List of team or engineer objects to render.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run renders the roster argument in the requested format.
+func (f *renderRosterFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		format string
+		roster types.Dynamic
+	)
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &format, &roster))
+	if resp.Error != nil {
+		return
+	}
+
+	// Check the format first, a typo should not be reported as a roster error.
+	if !slices.Contains(rosterFormats, format) {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Unsupported format %q, expected one of: %s.", format, strings.Join(rosterFormats, ", ")))
+		return
+	}
+
+	raw, err := roster.UnderlyingValue().ToTerraformValue(ctx)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, "Unable to read the roster: "+err.Error())
+		return
+	}
+
+	teams, err := parseRoster(raw)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, "Invalid roster: "+err.Error())
+		return
+	}
+
+	var rendered string
+	switch format {
+	case rosterFormatCodeowners:
+		rendered, err = renderRosterCodeowners(teams)
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(1, "Invalid roster for CODEOWNERS: "+err.Error())
+			return
+		}
+	case rosterFormatCSV:
+		rendered, err = renderRosterCSV(teams)
+		if err != nil {
+			resp.Error = function.NewFuncError("Unable to render the roster: " + err.Error())
+			return
+		}
+	case rosterFormatMarkdown:
+		rendered = renderRosterMarkdown(teams)
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, rendered))
+}
+
+// parseRoster reads a collection of team and engineer objects, or a single
+// team object. Consecutive engineers outside of a team are gathered in an
+// unnamed team.
+func parseRoster(raw tftypes.Value) ([]rosterTeam, error) {
+	var (
+		elements []tftypes.Value
+		err      error
+	)
+	if raw.Type().Is(tftypes.Object{}) {
+		elements = []tftypes.Value{raw}
+	} else if elements, err = rosterElements(raw); err != nil {
+		return nil, err
+	}
+
+	teams := []rosterTeam{}
+	for i, element := range elements {
+		var attributes map[string]tftypes.Value
+		if err := element.As(&attributes); err != nil {
+			return nil, fmt.Errorf("element %d is not an object", i)
+		}
+
+		if _, ok := attributes["engineers"]; !ok {
+			if len(teams) == 0 || teams[len(teams)-1].Name != "" {
+				teams = append(teams, rosterTeam{Pattern: rosterDefaultPattern})
+			}
+			engineer, err := parseRosterEngineer(attributes)
+			if err != nil {
+				return nil, fmt.Errorf("element %d: %w", i, err)
+			}
+			teams[len(teams)-1].Engineers = append(teams[len(teams)-1].Engineers, engineer)
+			continue
+		}
+
+		team := rosterTeam{Pattern: rosterDefaultPattern}
+		if team.Name, err = rosterString(attributes, "name"); err != nil {
+			return nil, fmt.Errorf("element %d: %w", i, err)
+		}
+		pattern, err := rosterString(attributes, "pattern")
+		if err != nil {
+			return nil, fmt.Errorf("element %d: %w", i, err)
+		}
+		if pattern != "" {
+			team.Pattern = pattern
+		}
+
+		members, err := rosterElements(attributes["engineers"])
+		if err != nil {
+			return nil, fmt.Errorf("element %d engineers: %w", i, err)
+		}
+		for j, member := range members {
+			var memberAttributes map[string]tftypes.Value
+			if err := member.As(&memberAttributes); err != nil {
+				return nil, fmt.Errorf("element %d engineer %d is not an object", i, j)
+			}
+			engineer, err := parseRosterEngineer(memberAttributes)
+			if err != nil {
+				return nil, fmt.Errorf("element %d engineer %d: %w", i, j, err)
+			}
+			team.Engineers = append(team.Engineers, engineer)
+		}
+
+		teams = append(teams, team)
+	}
+
+	return teams, nil
+}
+
+// rosterElements returns the elements of a list, set or tuple value, or the
+// values of a map sorted by key. A null value has no elements.
+func rosterElements(raw tftypes.Value) ([]tftypes.Value, error) {
+	if raw.IsNull() {
+		return nil, nil
+	}
+
+	if raw.Type().Is(tftypes.Map{}) {
+		var values map[string]tftypes.Value
+		if err := raw.As(&values); err != nil {
+			return nil, err
+		}
+		elements := make([]tftypes.Value, 0, len(values))
+		for _, key := range sortedKeys(values) {
+			elements = append(elements, values[key])
+		}
+		return elements, nil
+	}
+
+	var elements []tftypes.Value
+	if err := raw.As(&elements); err != nil {
+		return nil, fmt.Errorf("expected a list of objects, got %s", raw.Type())
+	}
+
+	return elements, nil
+}
+
+// parseRosterEngineer reads an engineer object.
+func parseRosterEngineer(attributes map[string]tftypes.Value) (rosterEngineer, error) {
+	var (
+		engineer rosterEngineer
+		err      error
+	)
+
+	if engineer.Id, err = rosterString(attributes, "id"); err != nil {
+		return engineer, err
+	}
+	if engineer.Name, err = rosterString(attributes, "name"); err != nil {
+		return engineer, err
+	}
+	if engineer.Email, err = rosterString(attributes, "email"); err != nil {
+		return engineer, err
+	}
+
+	return engineer, nil
+}
+
+// rosterString returns the string attribute with the given name, or an
+// empty string when it is missing or null.
+func rosterString(attributes map[string]tftypes.Value, name string) (string, error) {
+	value, ok := attributes[name]
+	if !ok || value.IsNull() {
+		return "", nil
+	}
+
+	var s string
+	if err := value.As(&s); err != nil {
+		return "", fmt.Errorf("attribute %s must be a string", name)
+	}
+
+	return s, nil
+}
+
+// renderRosterCodeowners renders one CODEOWNERS rule per team, owned by the
+// emails of its engineers and preceded by the team name as a comment. Teams
+// without any email are left out: a rule without owners would remove the
+// owners that earlier rules give to the same files.
+func renderRosterCodeowners(teams []rosterTeam) (string, error) {
+	var b strings.Builder
+
+	for _, team := range teams {
+		var owners []string
+		for _, engineer := range team.Engineers {
+			if engineer.Email == "" {
+				continue
+			}
+			// Owners are separated by whitespace, an email must be a
+			// single bare address.
+			if err := validateEmail(engineer.Email); err != nil {
+				return "", fmt.Errorf("engineer %q has an invalid email %q: %w", engineer.Name, engineer.Email, err)
+			}
+			owners = append(owners, engineer.Email)
+		}
+		if len(owners) == 0 {
+			continue
+		}
+
+		if strings.ContainsAny(team.Pattern, "\r\n") {
+			return "", fmt.Errorf("pattern of team %q spans several lines", team.Name)
+		}
+		// Spaces in patterns must be escaped, owners are separated by
+		// them, and a leading # would turn the rule into a comment.
+		pattern := strings.ReplaceAll(team.Pattern, " ", `\ `)
+		if strings.HasPrefix(pattern, "#") {
+			pattern = `\` + pattern
+		}
+
+		if b.Len() > 0 {
+			b.WriteString("\n")
+		}
+		if team.Name != "" {
+			b.WriteString("# " + strings.Join(strings.Fields(team.Name), " ") + "\n")
+		}
+		b.WriteString(pattern + " " + strings.Join(owners, " ") + "\n")
+	}
+
+	return b.String(), nil
+}
+
+// renderRosterCSV renders one record per engineer and team, with a header.
+func renderRosterCSV(teams []rosterTeam) (string, error) {
+	var b strings.Builder
+
+	w := csv.NewWriter(&b)
+	records := [][]string{{"team", "id", "name", "email"}}
+	for _, team := range teams {
+		for _, engineer := range team.Engineers {
+			records = append(records, []string{team.Name, engineer.Id, engineer.Name, engineer.Email})
+		}
+	}
+	if err := w.WriteAll(records); err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}
+
+// renderRosterMarkdown renders a table with a row per engineer and team. The
+// team column is left out when no engineer belongs to a named team.
+func renderRosterMarkdown(teams []rosterTeam) string {
+	var b strings.Builder
+
+	withTeams := false
+	for _, team := range teams {
+		withTeams = withTeams || team.Name != ""
+	}
+
+	columns := []string{"Name", "Email"}
+	if withTeams {
+		columns = append([]string{"Team"}, columns...)
+	}
+	writeRow := func(cells []string) {
+		b.WriteString("|")
+		for _, cell := range cells {
+			b.WriteString(" " + escapeMarkdownCell(cell) + " |")
+		}
+		b.WriteString("\n")
+	}
+
+	writeRow(columns)
+	b.WriteString("|" + strings.Repeat(" --- |", len(columns)) + "\n")
+	for _, team := range teams {
+		for _, engineer := range team.Engineers {
+			cells := []string{engineer.Name, engineer.Email}
+			if withTeams {
+				cells = append([]string{team.Name}, cells...)
+			}
+			writeRow(cells)
+		}
+	}
+
+	return b.String()
+}
+
+// markdownCellEscaper escapes the characters that would end or break a
+// Markdown table cell.
+var markdownCellEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`|`, `\|`,
+	"\r\n", "<br>",
+	"\n", "<br>",
+	"\r", "<br>",
+)
+
+// escapeMarkdownCell returns s escaped for use in a Markdown table cell.
+func escapeMarkdownCell(s string) string {
+	return markdownCellEscaper.Replace(strings.TrimSpace(s))
+}
@@ -0,0 +1,339 @@
+package provider
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+const testAccRenderRosterLocals = `
+locals {
+  roster = [
+    {
+      name    = "Platform | Core"
+      pattern = "/infra/"
+      engineers = [
+        { id = "1", name = "Ada, \"The Countess\"", email = "ada@liatriolife.com" },
+        { id = "2", name = "Grace", email = "grace@liatriolife.com" },
+      ]
+    },
+  ]
+}
+`
+
+func TestRenderRosterFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRenderRosterLocals + `
+output "codeowners" {
+  value = provider::devops::render_roster("codeowners", local.roster)
+}
+
+output "csv" {
+  value = provider::devops::render_roster("csv", local.roster)
+}
+
+output "markdown" {
+  value = provider::devops::render_roster("markdown", local.roster)
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("codeowners", "# Platform | Core\n/infra/ ada@liatriolife.com grace@liatriolife.com\n"),
+					resource.TestCheckOutput("csv", "team,id,name,email\nPlatform | Core,1,\"Ada, \"\"The Countess\"\"\",ada@liatriolife.com\nPlatform | Core,2,Grace,grace@liatriolife.com\n"),
+					resource.TestCheckOutput("markdown", "| Team | Name | Email |\n| --- | --- | --- |\n| Platform \\| Core | Ada, \"The Countess\" | ada@liatriolife.com |\n| Platform \\| Core | Grace | grace@liatriolife.com |\n"),
+				),
+			},
+			{
+				// The format is checked before the roster, which is invalid too.
+				Config: `
+output "test" {
+  value = provider::devops::render_roster("yaml", ["not an object"])
+}
+`,
+				ExpectError: regexp.MustCompile(`Unsupported format`),
+			},
+		},
+	})
+}
+
+var (
+	testRosterEngineerType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"id":    tftypes.String,
+		"name":  tftypes.String,
+		"email": tftypes.String,
+	}}
+	testRosterTeamType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"name":      tftypes.String,
+		"pattern":   tftypes.String,
+		"engineers": tftypes.List{ElementType: testRosterEngineerType},
+	}}
+)
+
+// testRosterEngineer returns an engineer object as given to render_roster.
+func testRosterEngineer(id string, name string, email string) tftypes.Value {
+	return tftypes.NewValue(testRosterEngineerType, map[string]tftypes.Value{
+		"id":    tftypes.NewValue(tftypes.String, id),
+		"name":  tftypes.NewValue(tftypes.String, name),
+		"email": tftypes.NewValue(tftypes.String, email),
+	})
+}
+
+// testRosterTeam returns a team object as given to render_roster, with a
+// null pattern when pattern is empty.
+func testRosterTeam(name string, pattern string, engineers ...tftypes.Value) tftypes.Value {
+	patternValue := tftypes.NewValue(tftypes.String, nil)
+	if pattern != "" {
+		patternValue = tftypes.NewValue(tftypes.String, pattern)
+	}
+
+	return tftypes.NewValue(testRosterTeamType, map[string]tftypes.Value{
+		"name":      tftypes.NewValue(tftypes.String, name),
+		"pattern":   patternValue,
+		"engineers": tftypes.NewValue(tftypes.List{ElementType: testRosterEngineerType}, engineers),
+	})
+}
+
+func TestParseRoster(t *testing.T) {
+	ada := testRosterEngineer("1", "Ada", "ada@liatriolife.com")
+	grace := testRosterEngineer("2", "Grace", "grace@liatriolife.com")
+	linus := testRosterEngineer("3", "Linus", "linus@liatriolife.com")
+
+	adaEngineer := rosterEngineer{Id: "1", Name: "Ada", Email: "ada@liatriolife.com"}
+	graceEngineer := rosterEngineer{Id: "2", Name: "Grace", Email: "grace@liatriolife.com"}
+	linusEngineer := rosterEngineer{Id: "3", Name: "Linus", Email: "linus@liatriolife.com"}
+
+	cases := map[string]struct {
+		roster  tftypes.Value
+		want    []rosterTeam
+		wantErr string
+	}{
+		"list of teams": {
+			roster: tftypes.NewValue(tftypes.List{ElementType: testRosterTeamType}, []tftypes.Value{
+				testRosterTeam("dev", "/src/", ada, grace),
+				testRosterTeam("ops", "", linus),
+			}),
+			want: []rosterTeam{
+				{Name: "dev", Pattern: "/src/", Engineers: []rosterEngineer{adaEngineer, graceEngineer}},
+				{Name: "ops", Pattern: "*", Engineers: []rosterEngineer{linusEngineer}},
+			},
+		},
+		"map of teams sorted by key": {
+			roster: tftypes.NewValue(tftypes.Map{ElementType: testRosterTeamType}, map[string]tftypes.Value{
+				"b": testRosterTeam("ops", "", linus),
+				"a": testRosterTeam("dev", "", ada),
+			}),
+			want: []rosterTeam{
+				{Name: "dev", Pattern: "*", Engineers: []rosterEngineer{adaEngineer}},
+				{Name: "ops", Pattern: "*", Engineers: []rosterEngineer{linusEngineer}},
+			},
+		},
+		"single team": {
+			roster: testRosterTeam("dev", "docs/", ada),
+			want: []rosterTeam{
+				{Name: "dev", Pattern: "docs/", Engineers: []rosterEngineer{adaEngineer}},
+			},
+		},
+		"team without engineers": {
+			roster: testRosterTeam("dev", ""),
+			want: []rosterTeam{
+				{Name: "dev", Pattern: "*"},
+			},
+		},
+		"list of engineers": {
+			roster: tftypes.NewValue(tftypes.List{ElementType: testRosterEngineerType}, []tftypes.Value{ada, grace}),
+			want: []rosterTeam{
+				{Pattern: "*", Engineers: []rosterEngineer{adaEngineer, graceEngineer}},
+			},
+		},
+		"engineers outside of teams": {
+			roster: tftypes.NewValue(tftypes.Tuple{ElementTypes: []tftypes.Type{
+				testRosterEngineerType, testRosterEngineerType, testRosterTeamType, testRosterEngineerType,
+			}}, []tftypes.Value{ada, grace, testRosterTeam("ops", "", linus), linus}),
+			want: []rosterTeam{
+				{Pattern: "*", Engineers: []rosterEngineer{adaEngineer, graceEngineer}},
+				{Name: "ops", Pattern: "*", Engineers: []rosterEngineer{linusEngineer}},
+				{Pattern: "*", Engineers: []rosterEngineer{linusEngineer}},
+			},
+		},
+		"null roster": {
+			roster: tftypes.NewValue(tftypes.List{ElementType: testRosterTeamType}, nil),
+			want:   []rosterTeam{},
+		},
+		"not a collection": {
+			roster:  tftypes.NewValue(tftypes.String, "dev"),
+			wantErr: "expected a list of objects",
+		},
+		"element not an object": {
+			roster:  tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "ada")}),
+			wantErr: "element 0 is not an object",
+		},
+		"attribute not a string": {
+			roster: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.Number}}, map[string]tftypes.Value{
+				"id": tftypes.NewValue(tftypes.Number, 1),
+			}),
+			wantErr: "attribute id must be a string",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := parseRoster(tc.roster)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected an error containing %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("expected %+v, got %+v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestRenderRosterCodeowners(t *testing.T) {
+	ada := rosterEngineer{Id: "1", Name: "Ada", Email: "ada@liatriolife.com"}
+	grace := rosterEngineer{Id: "2", Name: "Grace", Email: "grace@liatriolife.com"}
+	noEmail := rosterEngineer{Id: "3", Name: "Linus"}
+
+	cases := map[string]struct {
+		teams   []rosterTeam
+		want    string
+		wantErr string
+	}{
+		"teams": {
+			teams: []rosterTeam{
+				{Name: "dev", Pattern: "*", Engineers: []rosterEngineer{ada, noEmail, grace}},
+				{Name: "ops", Pattern: "/infra/", Engineers: []rosterEngineer{grace}},
+			},
+			want: "# dev\n* ada@liatriolife.com grace@liatriolife.com\n\n# ops\n/infra/ grace@liatriolife.com\n",
+		},
+		"unnamed team": {
+			teams: []rosterTeam{{Pattern: "*", Engineers: []rosterEngineer{ada}}},
+			want:  "* ada@liatriolife.com\n",
+		},
+		"multi-line team name": {
+			teams: []rosterTeam{{Name: "dev\r\nteam", Pattern: "*", Engineers: []rosterEngineer{ada}}},
+			want:  "# dev team\n* ada@liatriolife.com\n",
+		},
+		"pattern with spaces": {
+			teams: []rosterTeam{{Pattern: "/My Docs/", Engineers: []rosterEngineer{ada}}},
+			want:  "/My\\ Docs/ ada@liatriolife.com\n",
+		},
+		"pattern starting with a hash": {
+			teams: []rosterTeam{{Pattern: "#notes.md", Engineers: []rosterEngineer{ada}}},
+			want:  "\\#notes.md ada@liatriolife.com\n",
+		},
+		"teams without emails are left out": {
+			teams: []rosterTeam{
+				{Name: "empty", Pattern: "*"},
+				{Name: "dev", Pattern: "/src/", Engineers: []rosterEngineer{ada}},
+				{Name: "unknown", Pattern: "/docs/", Engineers: []rosterEngineer{noEmail}},
+			},
+			want: "# dev\n/src/ ada@liatriolife.com\n",
+		},
+		"no owners at all": {
+			teams: []rosterTeam{{Name: "empty", Pattern: "*", Engineers: []rosterEngineer{noEmail}}},
+			want:  "",
+		},
+		"email with whitespace": {
+			teams:   []rosterTeam{{Pattern: "*", Engineers: []rosterEngineer{{Name: "Ada", Email: "ada@liatriolife.com grace@liatriolife.com"}}}},
+			wantErr: `engineer "Ada" has an invalid email`,
+		},
+		"email with display name": {
+			teams:   []rosterTeam{{Pattern: "*", Engineers: []rosterEngineer{{Name: "Ada", Email: "Ada <ada@liatriolife.com>"}}}},
+			wantErr: `engineer "Ada" has an invalid email`,
+		},
+		"multi-line pattern": {
+			teams:   []rosterTeam{{Name: "dev", Pattern: "/src/\n*", Engineers: []rosterEngineer{ada}}},
+			wantErr: `pattern of team "dev" spans several lines`,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := renderRosterCodeowners(tc.teams)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected an error containing %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got != tc.want {
+				t.Errorf("expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestRenderRosterCSV(t *testing.T) {
+	teams := []rosterTeam{
+		{Name: "dev", Engineers: []rosterEngineer{
+			{Id: "1", Name: "Ada, \"The Countess\"", Email: "ada@liatriolife.com"},
+			{Id: "2", Name: "Grace\nHopper", Email: "grace@liatriolife.com"},
+		}},
+		{Name: "empty"},
+		{Engineers: []rosterEngineer{{Id: "3", Name: "Linus"}}},
+	}
+
+	got, err := renderRosterCSV(teams)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := "team,id,name,email\n" +
+		"dev,1,\"Ada, \"\"The Countess\"\"\",ada@liatriolife.com\n" +
+		"dev,2,\"Grace\nHopper\",grace@liatriolife.com\n" +
+		",3,Linus,\n"
+	if got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestRenderRosterMarkdown(t *testing.T) {
+	cases := map[string]struct {
+		teams []rosterTeam
+		want  string
+	}{
+		"teams": {
+			teams: []rosterTeam{
+				{Name: "Platform | Core", Engineers: []rosterEngineer{{Name: "Ada\nLovelace", Email: "ada@liatriolife.com"}}},
+				{Engineers: []rosterEngineer{{Name: `C:\Grace`, Email: "grace@liatriolife.com"}}},
+			},
+			want: "| Team | Name | Email |\n| --- | --- | --- |\n" +
+				"| Platform \\| Core | Ada<br>Lovelace | ada@liatriolife.com |\n" +
+				"|  | C:\\\\Grace | grace@liatriolife.com |\n",
+		},
+		"engineers only": {
+			teams: []rosterTeam{{Engineers: []rosterEngineer{{Name: " Ada ", Email: "ada@liatriolife.com"}}}},
+			want:  "| Name | Email |\n| --- | --- |\n| Ada | ada@liatriolife.com |\n",
+		},
+		"empty": {
+			want: "| Name | Email |\n| --- | --- |\n",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := renderRosterMarkdown(tc.teams); got != tc.want {
+				t.Errorf("expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
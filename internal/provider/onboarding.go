@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// onboardingRequest is the body of the onboarding request.
+type onboardingRequest struct {
+	Steps []string `json:"steps,omitempty"`
+}
+
+// StartEngineerOnboarding - Kicks off the onboarding workflow of an engineer,
+// limited to steps when given. The returned operation reports the progress
+// of the workflow
+func (c *Client) StartEngineerOnboarding(ctx context.Context, engineerID string, steps []string) (*Operation, error) {
+	rb, err := json.Marshal(onboardingRequest{Steps: steps})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/engineers/%s/onboarding", c.HostURL, engineerID), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, res, err := c.doRequestWithResponse(req)
+	if err != nil {
+		return nil, err
+	}
+
+	operation := Operation{}
+	err = json.Unmarshal(body, &operation)
+	if err != nil {
+		return nil, err
+	}
+	if operation.Link == "" {
+		operation.Link = res.Header.Get("Location")
+	}
+
+	return &operation, nil
+}
@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Statuses of an asynchronous operation.
+const (
+	operationStatusPending = "pending"
+	operationStatusRunning = "running"
+	operationStatusReady   = "ready"
+	operationStatusFailed  = "failed"
+)
+
+const (
+	// operationPollInitialInterval is the default wait before polling an
+	// operation for the first time, it doubles after every poll.
+	operationPollInitialInterval = 500 * time.Millisecond
+
+	// operationPollMaxInterval caps the wait between two polls.
+	operationPollMaxInterval = 10 * time.Second
+)
+
+// Operation maps an asynchronous operation returned by the DevOps API.
+type Operation struct {
+	Id     string `json:"id,omitempty"`
+	Status string `json:"status"`
+	// Link is the URL of the operation, either absolute or relative to the host.
+	Link       string    `json:"operation,omitempty"`
+	Error      string    `json:"error,omitempty"`
+	EngineerId string    `json:"engineer_id,omitempty"`
+	Engineer   *Engineer `json:"engineer,omitempty"`
+	// Messages is the progress log of the operation, oldest first.
+	Messages []string `json:"messages,omitempty"`
+}
+
+// IncompleteOperationError is returned when the API accepted an engineer
+// but the outcome of its creation is unknown, because waiting for the
+// operation timed out or the operation does not name the engineer. The
+// engineer may exist in the API all the same.
+type IncompleteOperationError struct {
+	// Link is the absolute URL of the operation.
+	Link string
+	Err  error
+}
+
+func (e *IncompleteOperationError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *IncompleteOperationError) Unwrap() error {
+	return e.Err
+}
+
+// GetOperation - Returns the current status of an asynchronous operation
+func (c *Client) GetOperation(ctx context.Context, link string) (*Operation, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", c.operationURL(link), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	operation := Operation{}
+	err = json.Unmarshal(body, &operation)
+	if err != nil {
+		return nil, err
+	}
+
+	return &operation, nil
+}
+
+// waitForEngineer polls operation with exponential backoff until it is
+// ready, failed or ctx is done, and returns the engineer it provisioned.
+func (c *Client) waitForEngineer(ctx context.Context, operation Operation) (*Engineer, error) {
+	if operation.Link == "" {
+		return nil, fmt.Errorf("engineer creation was accepted without an operation to poll")
+	}
+
+	done, err := c.waitForOperation(ctx, operation, nil)
+	if err != nil {
+		// Only a failed operation tells that the engineer was not created
+		if ctx.Err() != nil {
+			return nil, &IncompleteOperationError{Link: c.operationURL(operation.Link), Err: err}
+		}
+		return nil, err
+	}
+
+	if done.Engineer != nil {
+		return done.Engineer, nil
+	}
+	if done.EngineerId == "" {
+		return nil, &IncompleteOperationError{
+			Link: c.operationURL(done.Link),
+			Err:  errors.New("operation " + done.Link + " is ready but does not name the engineer it created"),
+		}
+	}
+	engineer, err := c.getEngineer(ctx, done.EngineerId, "")
+	if err != nil {
+		return nil, err
+	}
+	return &engineer, nil
+}
+
+// waitForOperation polls operation with exponential backoff until it is
+// ready, failed or ctx is done, and returns it once ready. When progress is
+// not nil it is called with the operation after every poll.
+func (c *Client) waitForOperation(ctx context.Context, operation Operation, progress func(Operation)) (*Operation, error) {
+	interval := c.operationPollInterval
+	for {
+		if progress != nil {
+			progress(operation)
+		}
+
+		switch operation.Status {
+		case operationStatusReady:
+			return &operation, nil
+		case operationStatusFailed:
+			return nil, fmt.Errorf("operation %s failed: %s", operation.Link, operation.Error)
+		case operationStatusPending, operationStatusRunning, "":
+		default:
+			return nil, fmt.Errorf("operation %s has unexpected status %q", operation.Link, operation.Status)
+		}
+
+		if operation.Link == "" {
+			return nil, fmt.Errorf("operation is %q without a link to poll", operation.Status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timed out waiting for operation %s, last status %q: %w", operation.Link, operation.Status, ctx.Err())
+		case <-time.After(interval):
+		}
+		interval = min(2*interval, operationPollMaxInterval)
+
+		link := operation.Link
+		next, err := c.GetOperation(ctx, link)
+		if err != nil {
+			return nil, fmt.Errorf("polling operation %s: %w", link, err)
+		}
+		operation = *next
+		if operation.Link == "" {
+			operation.Link = link
+		}
+	}
+}
+
+// operationURL resolves an operation link relative to the host.
+func (c *Client) operationURL(link string) string {
+	if strings.HasPrefix(link, "http://") || strings.HasPrefix(link, "https://") {
+		return link
+	}
+	return strings.TrimSuffix(c.HostURL, "/") + "/" + strings.TrimPrefix(link, "/")
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCreateEngineer_async(t *testing.T) {
+	cases := map[string]struct {
+		mode    string
+		timeout time.Duration
+		wantErr string
+		// wantIncomplete is set when the engineer may exist after all.
+		wantIncomplete bool
+	}{
+		"ready": {
+			mode:    fakeCreateAsync,
+			timeout: time.Minute,
+		},
+		"failed": {
+			mode:    fakeCreateFailed,
+			timeout: time.Minute,
+			wantErr: fakeFailingStep + " failed",
+		},
+		"timeout": {
+			mode:           fakeCreatePending,
+			timeout:        100 * time.Millisecond,
+			wantErr:        "timed out waiting for operation",
+			wantIncomplete: true,
+		},
+		"ready without engineer": {
+			mode:           fakeCreateOrphaned,
+			timeout:        time.Minute,
+			wantErr:        "does not name the engineer it created",
+			wantIncomplete: true,
+		},
+	}
+
+	client, err := NewClient(&testAccAPI.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.operationPollInterval = 10 * time.Millisecond
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			email := "async." + tc.mode + "@liatriolife.com"
+			t.Cleanup(func() {
+				engineers, _ := client.GetEngineersByEmail(email)
+				for _, engineer := range engineers {
+					_ = client.DeleteEngineer(engineer.Id)
+				}
+			})
+
+			ctx, cancel := context.WithTimeout(context.Background(), tc.timeout)
+			defer cancel()
+
+			engineer, err := client.CreateEngineer(ctx, Engineer{
+				Name:   "async " + tc.mode,
+				Email:  email,
+				Labels: map[string]string{fakeCreateLabel: tc.mode},
+			})
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected error containing %q, got engineer %v and error %v", tc.wantErr, engineer, err)
+				}
+				if tc.mode == fakeCreatePending && !errors.Is(err, context.DeadlineExceeded) {
+					t.Errorf("expected the timeout to wrap context.DeadlineExceeded, got %v", err)
+				}
+				var incomplete *IncompleteOperationError
+				if got := errors.As(err, &incomplete); got != tc.wantIncomplete {
+					t.Fatalf("expected incomplete operation %t, got %t for %v", tc.wantIncomplete, got, err)
+				}
+				if tc.wantIncomplete && !strings.HasPrefix(incomplete.Link, testAccAPI.URL+"/operations/") {
+					t.Errorf("expected an absolute operation link, got %q", incomplete.Link)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if engineer.Id == "" || engineer.Email != email || engineer.Revision == "" {
+				t.Errorf("unexpected engineer %+v", engineer)
+			}
+		})
+	}
+}
@@ -5,7 +5,11 @@ import (
     "fmt"
     "os"
 
+    "github.com/hashicorp/terraform-plugin-framework/action"
     "github.com/hashicorp/terraform-plugin-framework/datasource"
+    "github.com/hashicorp/terraform-plugin-framework/ephemeral"
+    "github.com/hashicorp/terraform-plugin-framework/function"
+    "github.com/hashicorp/terraform-plugin-framework/list"
     "github.com/hashicorp/terraform-plugin-framework/path"
     "github.com/hashicorp/terraform-plugin-framework/provider"
     "github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -16,7 +20,11 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-    _ provider.Provider = &devopsProvider{}
+    _ provider.Provider                       = &devopsProvider{}
+    _ provider.ProviderWithFunctions          = &devopsProvider{}
+    _ provider.ProviderWithEphemeralResources = &devopsProvider{}
+    _ provider.ProviderWithListResources      = &devopsProvider{}
+    _ provider.ProviderWithActions            = &devopsProvider{}
 )
 
 // New is a helper function hashicupsProvidermplify provider server and testing implementation.
@@ -126,6 +134,9 @@ func (p *devopsProvider) Configure(ctx context.Context, req provider.ConfigureRe
 
     resp.DataSourceData = client
     resp.ResourceData = client
+    resp.EphemeralResourceData = client
+    resp.ListResourceData = client
+    resp.ActionData = client
 
     tflog.Info(ctx, "Configured DevOps Client", map[string]any{"success": true})
 }
@@ -134,6 +145,11 @@ func (p *devopsProvider) Configure(ctx context.Context, req provider.ConfigureRe
 func (p *devopsProvider) DataSources(_ context.Context) []func() datasource.DataSource {
     return []func() datasource.DataSource {
         NewEngineerDataSource,
+        NewEngineersDataSource,
+        NewDevDataSource,
+        NewOpsDataSource,
+        NewDevOpsDataSource,
+        NewAPIInfoDataSource,
     }
 }
 
@@ -141,5 +157,38 @@ func (p *devopsProvider) DataSources(_ context.Context) []func() datasource.Data
 func (p *devopsProvider) Resources(_ context.Context) []func() resource.Resource {
     return []func() resource.Resource {
         NewEngineerResource,
+        NewEngineersResource,
+        NewTeamMembershipResource,
+    }
+}
+
+// Actions defines the actions implemented in the provider.
+func (p *devopsProvider) Actions(_ context.Context) []func() action.Action {
+    return []func() action.Action {
+        NewEngineerOnboardAction,
+    }
+}
+
+// ListResources defines the list resources implemented in the provider.
+func (p *devopsProvider) ListResources(_ context.Context) []func() list.ListResource {
+    return []func() list.ListResource {
+        NewEngineerListResource,
+    }
+}
+
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *devopsProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+    return []func() ephemeral.EphemeralResource {
+        NewAPITokenEphemeralResource,
+    }
+}
+
+// Functions defines the functions implemented in the provider.
+func (p *devopsProvider) Functions(_ context.Context) []func() function.Function {
+    return []func() function.Function {
+        NewNormalizeEmailFunction,
+        NewEmailFromNameFunction,
+        NewEngineerHandleFunction,
+        NewRenderRosterFunction,
     }
 }
@@ -1,23 +1,27 @@
 package provider
 
 import (
+    "fmt"
+    "os"
+    "testing"
+
     "github.com/hashicorp/terraform-plugin-framework/providerserver"
     "github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
-const (
+var (
+    // testAccAPI is an in-memory DevOps API shared by every acceptance
+    // test, so they run without a real server.
+    testAccAPI = newFakeDevOpsAPI()
+
     // providerConfig is a shared configuration to combine with the actual
-    // test configuration so the HashiCups client is properly configured.
-    // It is also possible to use the HASHICUPS_ environment variables instead,
-    // such as updating the Makefile and running the testing through that tool.
-    providerConfig = `
+    // test configuration so the DevOps client talks to testAccAPI.
+    providerConfig = fmt.Sprintf(`
 provider "devops" {
-  host = "http://localhost:8080"
+  host = %q
 }
-`
-)
+`, testAccAPI.URL)
 
-var (
     // testAccProtoV6ProviderFactories are used to instantiate a provider during
     // acceptance testing. The factory function will be invoked for every Terraform
     // CLI command executed to create a provider server to which the CLI can
@@ -26,3 +30,21 @@ var (
         "devops": providerserver.NewProtocol6WithError(New("test")()),
     }
 )
+
+// testAccExternalProvidersEnv enables the acceptance tests that download
+// other providers from the registry, which the fake API cannot stand in for.
+const testAccExternalProvidersEnv = "DEVOPS_ACC_EXTERNAL_PROVIDERS"
+
+// testAccPreCheckExternalProviders skips tests that need registry access
+// unless testAccExternalProvidersEnv is set.
+func testAccPreCheckExternalProviders(t *testing.T) {
+    if os.Getenv(testAccExternalProvidersEnv) == "" {
+        t.Skip(testAccExternalProvidersEnv + " must be set to run tests that download providers from the registry")
+    }
+}
+
+func TestMain(m *testing.M) {
+    code := m.Run()
+    testAccAPI.Close()
+    os.Exit(code)
+}
@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Team types understood by the DevOps API. They double as the path
+// segment of the team endpoints, e.g. /dev/id/{id}.
+const (
+	teamTypeDev = "dev"
+	teamTypeOps = "ops"
+)
+
+// teamTypes lists every valid team type.
+var teamTypes = []string{teamTypeDev, teamTypeOps}
+
+// Team maps a dev or ops team returned by the DevOps API.
+type Team struct {
+	Id        string     `json:"id"`
+	Name      string     `json:"name"`
+	Engineers []Engineer `json:"engineers"`
+}
+
+// HasEngineer reports whether the engineer with the given ID is a member of the team.
+func (t *Team) HasEngineer(engineerID string) bool {
+	for _, engineer := range t.Engineers {
+		if engineer.Id == engineerID {
+			return true
+		}
+	}
+	return false
+}
+
+// GetTeam - Returns a specific dev or ops team
+func (c *Client) GetTeam(teamType string, teamID string) (*Team, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/%s/id/%s", c.HostURL, teamType, teamID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	team := Team{}
+	err = json.Unmarshal(body, &team)
+	if err != nil {
+		return nil, err
+	}
+
+	return &team, nil
+}
+
+// GetTeamsByName - Returns every dev or ops team with the given name
+func (c *Client) GetTeamsByName(teamType string, name string) ([]Team, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/%s/name/%s", c.HostURL, teamType, url.PathEscape(name)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if errors.Is(err, ErrNotFound) {
+		return []Team{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	teams := []Team{}
+	err = json.Unmarshal(body, &teams)
+	if err != nil {
+		return nil, err
+	}
+
+	return teams, nil
+}
+
+// AddTeamMember - Adds a single engineer to a dev or ops team without
+// touching the rest of its members
+func (c *Client) AddTeamMember(teamType string, teamID string, engineerID string) error {
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/%s/%s/engineers/%s", c.HostURL, teamType, teamID, engineerID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	return err
+}
+
+// RemoveTeamMember - Removes a single engineer from a dev or ops team
+func (c *Client) RemoveTeamMember(teamType string, teamID string, engineerID string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/%s/%s/engineers/%s", c.HostURL, teamType, teamID, engineerID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	return err
+}
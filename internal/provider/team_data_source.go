@@ -0,0 +1,229 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                     = &teamDataSource{}
+	_ datasource.DataSourceWithConfigure        = &teamDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &teamDataSource{}
+)
+
+// NewDevDataSource is a helper function to simplify the provider implementation.
+func NewDevDataSource() datasource.DataSource {
+	return &teamDataSource{teamType: teamTypeDev}
+}
+
+// NewOpsDataSource is a helper function to simplify the provider implementation.
+func NewOpsDataSource() datasource.DataSource {
+	return &teamDataSource{teamType: teamTypeOps}
+}
+
+// teamDataSource reads a dev or ops team, depending on teamType. Both team
+// types share the same API shape.
+type teamDataSource struct {
+	client   *Client
+	teamType string
+}
+
+// teamDataSourceModel maps the data source schema data.
+type teamDataSourceModel struct {
+	Id        types.String      `tfsdk:"id"`
+	Name      types.String      `tfsdk:"name"`
+	Engineers []teamMemberModel `tfsdk:"engineers"`
+}
+
+// teamMemberModel maps an engineer of a team.
+type teamMemberModel struct {
+	Id    types.String `tfsdk:"id"`
+	Name  types.String `tfsdk:"name"`
+	Email EmailValue   `tfsdk:"email"`
+}
+
+// Metadata returns the data source type name.
+func (d *teamDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.teamType
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *teamDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Schema defines the schema for the data source.
+func (d *teamDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Looks up an existing %s team by exactly one of id or name.", d.teamType),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the team to look up.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the team to look up, it must match a single team.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"engineers": schema.ListNestedAttribute{
+				Description: "Members of the team, sorted by email then ID.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: teamMemberAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// ConfigValidators requires exactly one lookup attribute.
+func (d *teamDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *teamDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config teamDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	team, diags := d.lookup(config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := teamDataSourceModel{
+		Id:        types.StringValue(team.Id),
+		Name:      types.StringValue(team.Name),
+		Engineers: newTeamMemberModels(team.Engineers),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// lookup returns the single team selected by the configured id or name.
+func (d *teamDataSource) lookup(config teamDataSourceModel) (*Team, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var (
+		by    string
+		value string
+		teams []Team
+		err   error
+	)
+	if !config.Id.IsNull() {
+		by, value = "id", config.Id.ValueString()
+		var team *Team
+		team, err = d.client.GetTeam(d.teamType, value)
+		if errors.Is(err, ErrNotFound) {
+			err = nil
+		} else if err == nil {
+			teams = []Team{*team}
+		}
+	} else {
+		by, value = "name", config.Name.ValueString()
+		teams, err = d.client.GetTeamsByName(d.teamType, value)
+	}
+
+	if err != nil {
+		diags.AddError(
+			"Unable to Read DevOps "+d.teamType+" team",
+			fmt.Sprintf("Could not look up %s team by %s %q, unexpected error: %s", d.teamType, by, value, err),
+		)
+		return nil, diags
+	}
+
+	switch len(teams) {
+	case 0:
+		diags.AddAttributeError(
+			path.Root(by),
+			"Team Not Found",
+			fmt.Sprintf("No %s team with %s %q exists.", d.teamType, by, value),
+		)
+		return nil, diags
+	case 1:
+		return &teams[0], diags
+	}
+
+	ids := make([]string, len(teams))
+	for i, team := range teams {
+		ids[i] = team.Id
+	}
+	diags.AddAttributeError(
+		path.Root(by),
+		"Multiple Teams Found",
+		fmt.Sprintf("%d %s teams have %s %q: %s. Look the team up by id instead.", len(teams), d.teamType, by, value, strings.Join(ids, ", ")),
+	)
+	return nil, diags
+}
+
+// teamMemberAttributes returns the computed attributes describing a member
+// of a team.
+func teamMemberAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"name": schema.StringAttribute{
+			Computed: true,
+		},
+		"email": schema.StringAttribute{
+			CustomType: EmailType{},
+			Computed:   true,
+		},
+	}
+}
+
+// newTeamMemberModels returns the models of the given team members, sorted
+// so the list does not churn when the API reorders them.
+func newTeamMemberModels(engineers []Engineer) []teamMemberModel {
+	sorted := make([]Engineer, len(engineers))
+	copy(sorted, engineers)
+	sortEngineers(sorted)
+
+	members := make([]teamMemberModel, len(sorted))
+	for i, engineer := range sorted {
+		members[i] = teamMemberModel{
+			Id:    types.StringValue(engineer.Id),
+			Name:  types.StringValue(engineer.Name),
+			Email: NewEmailValue(engineer.Email),
+		}
+	}
+	return members
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestTeamDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read the seeded teams by id and by name, with a member
+			{
+				Config: providerConfig + `
+resource "devops_engineer" "member" {
+  name  = "member"
+  email = "member@liatriolife.com"
+}
+
+resource "devops_team_membership" "member" {
+  team_type   = "dev"
+  team_id     = "` + testAccDevTeamId + `"
+  engineer_id = devops_engineer.member.id
+}
+
+data "devops_dev" "by_id" {
+  id = "` + testAccDevTeamId + `"
+
+  depends_on = [devops_team_membership.member]
+}
+
+data "devops_ops" "by_name" {
+  name = "` + testAccOpsTeamName + `"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.devops_dev.by_id", "name", testAccDevTeamName),
+					resource.TestCheckResourceAttr("data.devops_dev.by_id", "engineers.#", "1"),
+					resource.TestCheckResourceAttr("data.devops_dev.by_id", "engineers.0.email", "member@liatriolife.com"),
+					resource.TestCheckResourceAttr("data.devops_ops.by_name", "id", testAccOpsTeamId),
+					resource.TestCheckResourceAttr("data.devops_ops.by_name", "engineers.#", "0"),
+				),
+			},
+			// Exactly one lookup attribute must be set
+			{
+				Config:      providerConfig + `data "devops_dev" "test" {}`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			// Unknown teams are reported as not found
+			{
+				Config:      providerConfig + `data "devops_ops" "test" { name = "no-such-team" }`,
+				ExpectError: regexp.MustCompile(`Team Not Found`),
+			},
+		},
+	})
+}
@@ -0,0 +1,237 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &teamMembershipResource{}
+	_ resource.ResourceWithConfigure   = &teamMembershipResource{}
+	_ resource.ResourceWithImportState = &teamMembershipResource{}
+)
+
+// NewTeamMembershipResource is a helper function to simplify the provider implementation.
+func NewTeamMembershipResource() resource.Resource {
+	return &teamMembershipResource{}
+}
+
+// teamMembershipResource is the resource implementation. Unlike a team
+// resource it is additive: it only manages a single engineer's membership
+// and leaves every other member of the team alone.
+type teamMembershipResource struct {
+	client *Client
+}
+
+// teamMembershipResourceModel maps the resource schema data.
+type teamMembershipResourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	TeamType   types.String `tfsdk:"team_type"`
+	TeamId     types.String `tfsdk:"team_id"`
+	EngineerId types.String `tfsdk:"engineer_id"`
+}
+
+// Metadata returns the resource type name.
+func (r *teamMembershipResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_membership"
+}
+
+// Schema defines the schema for the resource.
+func (r *teamMembershipResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Adds a single engineer to a dev or ops team without managing the rest of its members.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Membership identifier in the form `<team_type>/<team_id>/<engineer_id>`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_type": schema.StringAttribute{
+				Description: "Type of the team, either `dev` or `ops`.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(teamTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				Description: "ID of the team the engineer joins.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"engineer_id": schema.StringAttribute{
+				Description: "ID of the engineer added to the team.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Create adds the engineer to the team and sets the initial Terraform state.
+func (r *teamMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan teamMembershipResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.AddTeamMember(plan.TeamType.ValueString(), plan.TeamId.ValueString(), plan.EngineerId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating team membership",
+			"Could not add engineer "+plan.EngineerId.ValueString()+" to "+plan.TeamType.ValueString()+" team "+plan.TeamId.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.Id = types.StringValue(teamMembershipID(plan.TeamType.ValueString(), plan.TeamId.ValueString(), plan.EngineerId.ValueString()))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *teamMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state teamMembershipResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	team, err := r.client.GetTeam(state.TeamType.ValueString(), state.TeamId.ValueString())
+	if errors.Is(err, ErrNotFound) {
+		tflog.Warn(ctx, "Team no longer exists, removing membership from state", map[string]interface{}{
+			"id": state.Id.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading team membership",
+			"Could not read "+state.TeamType.ValueString()+" team "+state.TeamId.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// The engineer was removed out of band, let Terraform add it back.
+	if !team.HasEngineer(state.EngineerId.ValueString()) {
+		tflog.Warn(ctx, "Engineer is no longer a team member, removing membership from state", map[string]interface{}{
+			"id": state.Id.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Id = types.StringValue(teamMembershipID(state.TeamType.ValueString(), state.TeamId.ValueString(), state.EngineerId.ValueString()))
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update is never called with changes since every attribute requires
+// replacement, but it must carry the plan over to satisfy the interface.
+func (r *teamMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan teamMembershipResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the engineer from the team and removes the Terraform state on success.
+func (r *teamMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state teamMembershipResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RemoveTeamMember(state.TeamType.ValueString(), state.TeamId.ValueString(), state.EngineerId.ValueString())
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"Error deleting team membership",
+			"Could not remove engineer "+state.EngineerId.ValueString()+" from "+state.TeamType.ValueString()+" team "+state.TeamId.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *teamMembershipResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState accepts an identifier in the form <team_type>/<team_id>/<engineer_id>.
+func (r *teamMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <team_type>/<team_id>/<engineer_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	if parts[0] != teamTypeDev && parts[0] != teamTypeOps {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Team type must be one of %q, got: %q", teamTypes, parts[0]),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_type"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("engineer_id"), parts[2])...)
+}
+
+// teamMembershipID builds the composite ID of a team membership.
+func teamMembershipID(teamType string, teamID string, engineerID string) string {
+	return teamType + "/" + teamID + "/" + engineerID
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const testAccTeamMembershipConfig = `
+resource "devops_engineer" "member" {
+  name  = "joiner"
+  email = "joiner@liatriolife.com"
+}
+
+resource "devops_team_membership" "test" {
+  team_type   = "ops"
+  team_id     = "` + testAccOpsTeamId + `"
+  engineer_id = devops_engineer.member.id
+}
+`
+
+func TestTeamMembershipResource(t *testing.T) {
+	client, err := NewClient(&testAccAPI.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var engineerID string
+	captureEngineerID := resource.TestCheckResourceAttrWith("devops_engineer.member", "id", func(id string) error {
+		engineerID = id
+		return nil
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccTeamMembershipConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					captureEngineerID,
+					resource.TestCheckResourceAttrPair("devops_team_membership.test", "engineer_id", "devops_engineer.member", "id"),
+					resource.TestCheckResourceAttrWith("devops_team_membership.test", "id", func(id string) error {
+						if want := teamMembershipID(teamTypeOps, testAccOpsTeamId, engineerID); id != want {
+							return fmt.Errorf("expected id %q, got %q", want, id)
+						}
+						return nil
+					}),
+					testAccCheckOpsTeamMember(&engineerID, true),
+				),
+			},
+			// ImportState testing with a <team_type>/<team_id>/<engineer_id> identifier
+			{
+				ResourceName: "devops_team_membership.test",
+				ImportState:  true,
+				ImportStateIdFunc: func(_ *terraform.State) (string, error) {
+					return teamTypeOps + "/" + testAccOpsTeamId + "/" + engineerID, nil
+				},
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  "devops_team_membership.test",
+				ImportState:   true,
+				ImportStateId: teamTypeOps + "/42",
+				ExpectError:   regexp.MustCompile(`Unexpected Import Identifier`),
+			},
+			// A member removed outside of Terraform drops from state and is
+			// added back
+			{
+				PreConfig: func() {
+					if err := client.RemoveTeamMember(teamTypeOps, testAccOpsTeamId, engineerID); err != nil {
+						t.Fatalf("removing the team member: %s", err)
+					}
+				},
+				Config: providerConfig + testAccTeamMembershipConfig,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("devops_team_membership.test", plancheck.ResourceActionCreate),
+					},
+				},
+				Check: testAccCheckOpsTeamMember(&engineerID, true),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+		CheckDestroy: testAccCheckOpsTeamMember(&engineerID, false),
+	})
+}
+
+// testAccCheckOpsTeamMember checks whether the engineer with the ID engineerID
+// points to is a member of the seeded ops team of the fake DevOps API.
+func testAccCheckOpsTeamMember(engineerID *string, want bool) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		if got := testAccAPI.isTeamMember(teamTypeOps, testAccOpsTeamId, *engineerID); got != want {
+			return fmt.Errorf("expected engineer %s to be an ops team member: %t, got %t", *engineerID, want, got)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// APIToken maps a short-lived DevOps API token.
+type APIToken struct {
+	Id     string   `json:"id"`
+	Token  string   `json:"token,omitempty"`
+	Scopes []string `json:"scopes"`
+	// ExpiresAt is the RFC 3339 time the token stops being accepted.
+	ExpiresAt string `json:"expires_at"`
+}
+
+// apiTokenRequest is the body of the token create and renew requests.
+type apiTokenRequest struct {
+	Scopes     []string `json:"scopes,omitempty"`
+	TTLSeconds int64    `json:"ttl_seconds"`
+}
+
+// CreateAPIToken - Mints a new token limited to scopes and valid for ttl
+func (c *Client) CreateAPIToken(ctx context.Context, scopes []string, ttl time.Duration) (*APIToken, error) {
+	return c.doTokenRequest(ctx, fmt.Sprintf("%s/tokens", c.HostURL), apiTokenRequest{
+		Scopes:     scopes,
+		TTLSeconds: int64(ttl.Seconds()),
+	})
+}
+
+// RenewAPIToken - Extends the validity of an existing token by ttl, the
+// token value itself does not change
+func (c *Client) RenewAPIToken(ctx context.Context, tokenID string, ttl time.Duration) (*APIToken, error) {
+	return c.doTokenRequest(ctx, fmt.Sprintf("%s/tokens/%s/renew", c.HostURL, tokenID), apiTokenRequest{
+		TTLSeconds: int64(ttl.Seconds()),
+	})
+}
+
+// RevokeAPIToken - Revokes a token before it expires
+func (c *Client) RevokeAPIToken(ctx context.Context, tokenID string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/tokens/%s", c.HostURL, tokenID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	return err
+}
+
+// doTokenRequest posts body to endpoint and decodes the returned token.
+func (c *Client) doTokenRequest(ctx context.Context, endpoint string, body apiTokenRequest) (*APIToken, error) {
+	rb, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	token := APIToken{}
+	err = json.Unmarshal(res, &token)
+	if err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}